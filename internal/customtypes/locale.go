@@ -0,0 +1,146 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = LocaleType{}
+	_ basetypes.StringValuableWithSemanticEquals = Locale{}
+	_ xattr.ValidateableAttribute                = Locale{}
+)
+
+// Language subtag followed by optional script, region or variant subtags.
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*$`)
+
+// LocaleType is a string type holding an IETF BCP 47 locale tag.
+type LocaleType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t LocaleType) String() string {
+	return "customtypes.LocaleType"
+}
+
+// ValueType returns the Value type.
+func (t LocaleType) ValueType(ctx context.Context) attr.Value {
+	return Locale{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t LocaleType) Equal(o attr.Type) bool {
+	other, ok := o.(LocaleType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t LocaleType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Locale{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t LocaleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Locale is an IETF BCP 47 locale tag. BCP 47 tags are case-insensitive, so
+// "en-US" and "en-us" are semantically equal, as are "en_US" and "en-US".
+type Locale struct {
+	basetypes.StringValue
+}
+
+// Type returns a LocaleType.
+func (v Locale) Type(_ context.Context) attr.Type {
+	return LocaleType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Locale) Equal(o attr.Value) bool {
+	other, ok := o.(Locale)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both tags are the same locale.
+func (v Locale) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Locale)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeLocale(v.ValueString()) == normalizeLocale(newValue.ValueString()), diags
+}
+
+// ValidateAttribute checks that the value looks like a BCP 47 locale tag.
+func (v Locale) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !localePattern.MatchString(v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Locale",
+			fmt.Sprintf("Locale must be an IETF BCP 47 language tag (e.g., en, en-US, pt-BR). Got: %s", v.ValueString()),
+		)
+	}
+}
+
+// Lowercases a locale tag and uses hyphens as the subtag separator.
+func normalizeLocale(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", "-"))
+}
+
+// NewLocaleNull creates a Locale with a null value.
+func NewLocaleNull() Locale {
+	return Locale{StringValue: basetypes.NewStringNull()}
+}
+
+// NewLocaleUnknown creates a Locale with an unknown value.
+func NewLocaleUnknown() Locale {
+	return Locale{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewLocaleValue creates a Locale with a known value.
+func NewLocaleValue(value string) Locale {
+	return Locale{StringValue: basetypes.NewStringValue(value)}
+}
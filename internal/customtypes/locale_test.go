@@ -0,0 +1,66 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestLocaleSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		updated  string
+		expected bool
+	}{
+		{"identical", "en", "en", true},
+		{"region casing", "en-US", "en-us", true},
+		{"underscore separator", "pt_BR", "pt-BR", true},
+		{"different region", "en-US", "en-GB", false},
+		{"different language", "en", "fr", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewLocaleValue(tt.current).StringSemanticEquals(context.Background(), NewLocaleValue(tt.updated))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestLocaleValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       Locale
+		expectError bool
+	}{
+		{"language", NewLocaleValue("en"), false},
+		{"language and region", NewLocaleValue("en-US"), false},
+		{"language and script", NewLocaleValue("zh-Hans"), false},
+		{"null", NewLocaleNull(), false},
+		{"single letter", NewLocaleValue("e"), true},
+		{"spaces", NewLocaleValue("en US"), true},
+		{"empty string", NewLocaleValue(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = MoneyAmountType{}
+	_ basetypes.StringValuableWithSemanticEquals = MoneyAmount{}
+	_ xattr.ValidateableAttribute                = MoneyAmount{}
+)
+
+// Non-negative decimal amount, as Paddle represents money and percentages.
+var moneyAmountPattern = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+// MoneyAmountType is a string type holding a decimal amount such as "2900" or "10.50".
+type MoneyAmountType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t MoneyAmountType) String() string {
+	return "customtypes.MoneyAmountType"
+}
+
+// ValueType returns the Value type.
+func (t MoneyAmountType) ValueType(ctx context.Context) attr.Value {
+	return MoneyAmount{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t MoneyAmountType) Equal(o attr.Type) bool {
+	other, ok := o.(MoneyAmountType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t MoneyAmountType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MoneyAmount{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t MoneyAmountType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// MoneyAmount is a decimal amount. Amounts are semantically equal when they
+// represent the same number, so "10" and "10.00" do not cause a diff.
+type MoneyAmount struct {
+	basetypes.StringValue
+}
+
+// Type returns a MoneyAmountType.
+func (v MoneyAmount) Type(_ context.Context) attr.Type {
+	return MoneyAmountType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v MoneyAmount) Equal(o attr.Value) bool {
+	other, ok := o.(MoneyAmount)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both amounts represent the same number.
+func (v MoneyAmount) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(MoneyAmount)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	current, ok := parseMoneyAmount(v.ValueString())
+	if !ok {
+		return false, diags
+	}
+
+	updated, ok := parseMoneyAmount(newValue.ValueString())
+	if !ok {
+		return false, diags
+	}
+
+	return current.Cmp(updated) == 0, diags
+}
+
+// ValidateAttribute checks that the value is a non-negative decimal number.
+func (v MoneyAmount) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, ok := parseMoneyAmount(v.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Amount",
+			fmt.Sprintf("Amount must be a non-negative decimal number as a string (e.g., 2900 or 10.50). Got: %s", v.ValueString()),
+		)
+	}
+}
+
+// Parses a decimal amount, rejecting the fraction and exponent forms big.Rat also accepts.
+func parseMoneyAmount(s string) (*big.Rat, bool) {
+	if !moneyAmountPattern.MatchString(s) {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+// NewMoneyAmountNull creates a MoneyAmount with a null value.
+func NewMoneyAmountNull() MoneyAmount {
+	return MoneyAmount{StringValue: basetypes.NewStringNull()}
+}
+
+// NewMoneyAmountUnknown creates a MoneyAmount with an unknown value.
+func NewMoneyAmountUnknown() MoneyAmount {
+	return MoneyAmount{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewMoneyAmountValue creates a MoneyAmount with a known value.
+func NewMoneyAmountValue(value string) MoneyAmount {
+	return MoneyAmount{StringValue: basetypes.NewStringValue(value)}
+}
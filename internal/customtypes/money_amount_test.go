@@ -0,0 +1,67 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestMoneyAmountSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		updated  string
+		expected bool
+	}{
+		{"identical", "2900", "2900", true},
+		{"trailing zeros", "10", "10.00", true},
+		{"leading zeros", "010.5", "10.50", true},
+		{"different amounts", "10", "10.01", false},
+		{"invalid current", "ten", "10", false},
+		{"invalid updated", "10", "1e1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewMoneyAmountValue(tt.current).StringSemanticEquals(context.Background(), NewMoneyAmountValue(tt.updated))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestMoneyAmountValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       MoneyAmount
+		expectError bool
+	}{
+		{"integer", NewMoneyAmountValue("2900"), false},
+		{"decimal", NewMoneyAmountValue("10.50"), false},
+		{"null", NewMoneyAmountNull(), false},
+		{"unknown", NewMoneyAmountUnknown(), false},
+		{"negative", NewMoneyAmountValue("-5"), true},
+		{"fraction", NewMoneyAmountValue("1/2"), true},
+		{"empty string", NewMoneyAmountValue(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = RFC3339Type{}
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
+	_ xattr.ValidateableAttribute                = RFC3339{}
+)
+
+// RFC3339Type is a string type holding an RFC 3339 timestamp.
+type RFC3339Type struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t RFC3339Type) String() string {
+	return "customtypes.RFC3339Type"
+}
+
+// ValueType returns the Value type.
+func (t RFC3339Type) ValueType(ctx context.Context) attr.Value {
+	return RFC3339{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(RFC3339Type)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t RFC3339Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t RFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// RFC3339 is an RFC 3339 timestamp. Timestamps are semantically equal when they
+// represent the same instant, whatever their offset or fractional precision.
+type RFC3339 struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC3339Type.
+func (v RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both timestamps represent the same instant.
+func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	current, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	updated, err := time.Parse(time.RFC3339, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return current.Equal(updated), diags
+}
+
+// ValidateAttribute checks that the value is an RFC 3339 timestamp.
+func (v RFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC 3339 Timestamp",
+			fmt.Sprintf("Value must be an RFC 3339 timestamp (e.g., 2025-12-31T23:59:59Z). Got: %s", v.ValueString()),
+		)
+	}
+}
+
+// NewRFC3339Null creates an RFC3339 with a null value.
+func NewRFC3339Null() RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringNull()}
+}
+
+// NewRFC3339Unknown creates an RFC3339 with an unknown value.
+func NewRFC3339Unknown() RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewRFC3339Value creates an RFC3339 with a known value.
+func NewRFC3339Value(value string) RFC3339 {
+	return RFC3339{StringValue: basetypes.NewStringValue(value)}
+}
@@ -0,0 +1,64 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestRFC3339SemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		updated  string
+		expected bool
+	}{
+		{"identical", "2025-12-31T23:59:59Z", "2025-12-31T23:59:59Z", true},
+		{"different offset", "2025-12-31T23:59:59Z", "2026-01-01T01:59:59+02:00", true},
+		{"fractional seconds", "2025-12-31T23:59:59Z", "2025-12-31T23:59:59.000000Z", true},
+		{"different instant", "2025-12-31T23:59:59Z", "2025-12-31T23:59:58Z", false},
+		{"invalid updated", "2025-12-31T23:59:59Z", "2025-12-31", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewRFC3339Value(tt.current).StringSemanticEquals(context.Background(), NewRFC3339Value(tt.updated))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       RFC3339
+		expectError bool
+	}{
+		{"utc", NewRFC3339Value("2025-12-31T23:59:59Z"), false},
+		{"offset", NewRFC3339Value("2025-12-31T23:59:59+02:00"), false},
+		{"null", NewRFC3339Null(), false},
+		{"date only", NewRFC3339Value("2025-12-31"), true},
+		{"empty string", NewRFC3339Value(""), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tt.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.ListTypable                    = UnorderedListType{}
+	_ basetypes.ListValuableWithSemanticEquals = UnorderedList{}
+)
+
+// UnorderedListType is a list type whose element order carries no meaning,
+// such as lists of IDs, country codes or event names returned by Paddle in any order.
+type UnorderedListType struct {
+	basetypes.ListType
+}
+
+// NewUnorderedListType returns an UnorderedListType with the given element type.
+func NewUnorderedListType(elemType attr.Type) UnorderedListType {
+	return UnorderedListType{ListType: basetypes.ListType{ElemType: elemType}}
+}
+
+// String returns a human readable string of the type name.
+func (t UnorderedListType) String() string {
+	return fmt.Sprintf("customtypes.UnorderedListType[%s]", t.ElementType())
+}
+
+// ValueType returns the Value type.
+func (t UnorderedListType) ValueType(ctx context.Context) attr.Value {
+	return UnorderedList{ListValue: basetypes.NewListNull(t.ElementType())}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t UnorderedListType) Equal(o attr.Type) bool {
+	other, ok := o.(UnorderedListType)
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+// ValueFromList returns a ListValuable type given a ListValue.
+func (t UnorderedListType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return UnorderedList{ListValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t UnorderedListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+// UnorderedList is a list whose values are semantically equal when they hold
+// the same elements, whatever their order.
+type UnorderedList struct {
+	basetypes.ListValue
+}
+
+// Type returns an UnorderedListType with the same element type.
+func (v UnorderedList) Type(ctx context.Context) attr.Type {
+	return NewUnorderedListType(v.ElementType(ctx))
+}
+
+// Equal returns true if the given value is equivalent.
+func (v UnorderedList) Equal(o attr.Value) bool {
+	other, ok := o.(UnorderedList)
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+// ListSemanticEquals returns true if both lists hold the same elements, the
+// same number of times each, in any order.
+func (v UnorderedList) ListSemanticEquals(_ context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UnorderedList)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	current := v.Elements()
+	updated := newValue.Elements()
+	if len(current) != len(updated) {
+		return false, diags
+	}
+
+	matched := make([]bool, len(updated))
+	for _, element := range current {
+		found := false
+		for i, candidate := range updated {
+			if !matched[i] && element.Equal(candidate) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// NewUnorderedListNull creates an UnorderedList with a null value.
+func NewUnorderedListNull(elemType attr.Type) UnorderedList {
+	return UnorderedList{ListValue: basetypes.NewListNull(elemType)}
+}
+
+// NewUnorderedListUnknown creates an UnorderedList with an unknown value.
+func NewUnorderedListUnknown(elemType attr.Type) UnorderedList {
+	return UnorderedList{ListValue: basetypes.NewListUnknown(elemType)}
+}
+
+// NewUnorderedListValue creates an UnorderedList with a known value.
+func NewUnorderedListValue(elemType attr.Type, elements []attr.Value) (UnorderedList, diag.Diagnostics) {
+	listValue, diags := basetypes.NewListValue(elemType, elements)
+	return UnorderedList{ListValue: listValue}, diags
+}
+
+// NewUnorderedListValueFrom creates an UnorderedList from a Go value such as a []string.
+func NewUnorderedListValueFrom(ctx context.Context, elemType attr.Type, elements any) (UnorderedList, diag.Diagnostics) {
+	listValue, diags := basetypes.NewListValueFrom(ctx, elemType, elements)
+	return UnorderedList{ListValue: listValue}, diags
+}
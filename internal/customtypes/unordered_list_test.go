@@ -0,0 +1,61 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnorderedListSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		updated  []string
+		expected bool
+	}{
+		{"identical", []string{"GB", "FR"}, []string{"GB", "FR"}, true},
+		{"reordered", []string{"GB", "FR", "DE"}, []string{"DE", "GB", "FR"}, true},
+		{"empty", []string{}, []string{}, true},
+		{"missing element", []string{"GB", "FR"}, []string{"GB"}, false},
+		{"different element", []string{"GB", "FR"}, []string{"GB", "DE"}, false},
+		{"duplicates differ", []string{"GB", "GB", "FR"}, []string{"GB", "FR", "FR"}, false},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, diags := NewUnorderedListValueFrom(ctx, types.StringType, tt.current)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			updated, diags := NewUnorderedListValueFrom(ctx, types.StringType, tt.updated)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			equal, diags := current.ListSemanticEquals(ctx, updated)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestUnorderedListType(t *testing.T) {
+	ctx := context.Background()
+	value, diags := NewUnorderedListValueFrom(ctx, types.StringType, []string{"pro_01", "pri_01"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !value.Type(ctx).Equal(NewUnorderedListType(types.StringType)) {
+		t.Errorf("expected %s, got %s", NewUnorderedListType(types.StringType), value.Type(ctx))
+	}
+	if value.Type(ctx).Equal(types.ListType{ElemType: types.StringType}) {
+		t.Error("expected unordered list type to differ from a plain list type")
+	}
+}
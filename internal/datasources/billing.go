@@ -0,0 +1,228 @@
+package datasources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attribute types of an amount of money, mapped like the unit_price of prices.
+var moneyAttrTypes = map[string]attr.Type{
+	"amount":        customtypes.MoneyAmountType{},
+	"currency_code": types.StringType,
+}
+
+// Attribute types of a duration, mapped like the billing_cycle of prices.
+var durationAttrTypes = map[string]attr.Type{
+	"frequency": types.Int64Type,
+	"interval":  types.StringType,
+}
+
+var timePeriodAttrTypes = map[string]attr.Type{
+	"starts_at": types.StringType,
+	"ends_at":   types.StringType,
+}
+
+var totalsAttrTypes = map[string]attr.Type{
+	"subtotal":      customtypes.MoneyAmountType{},
+	"discount":      customtypes.MoneyAmountType{},
+	"tax":           customtypes.MoneyAmountType{},
+	"total":         customtypes.MoneyAmountType{},
+	"grand_total":   customtypes.MoneyAmountType{},
+	"currency_code": types.StringType,
+}
+
+// Attribute types of the totals of a line, which carry no currency code.
+var lineTotalsAttrTypes = map[string]attr.Type{
+	"subtotal": totalsAttrTypes["subtotal"],
+	"discount": totalsAttrTypes["discount"],
+	"tax":      totalsAttrTypes["tax"],
+	"total":    totalsAttrTypes["total"],
+}
+
+// Returns the schema of an amount of money.
+func moneySchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"amount": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          customtypes.MoneyAmountType{},
+				MarkdownDescription: "Amount in the lowest denomination of the currency (e.g. cents for USD), as a string.",
+			},
+			"currency_code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Three-letter ISO 4217 currency code.",
+			},
+		},
+	}
+}
+
+// Returns the schema of a duration.
+func durationSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"frequency": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of intervals.",
+			},
+			"interval": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Interval unit: `day`, `week`, `month` or `year`.",
+			},
+		},
+	}
+}
+
+// Returns the schema of a time period.
+func timePeriodSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"starts_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the period starts.",
+			},
+			"ends_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the period ends.",
+			},
+		},
+	}
+}
+
+// Returns the schema of transaction totals.
+func totalsSchema(description string) schema.SingleNestedAttribute {
+	amount := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:            true,
+			CustomType:          customtypes.MoneyAmountType{},
+			MarkdownDescription: description + " In the lowest denomination of the currency, as a string.",
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"subtotal":    amount("Total before discount and tax."),
+			"discount":    amount("Total discount."),
+			"tax":         amount("Total tax on the subtotal."),
+			"total":       amount("Total after discount and tax."),
+			"grand_total": amount("Total after credits, that the customer pays."),
+			"currency_code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Three-letter ISO 4217 currency code.",
+			},
+		},
+	}
+}
+
+// Returns the schema of line item totals, which have no grand total or
+// currency code of their own.
+func lineTotalsSchema(description string) schema.SingleNestedAttribute {
+	lineTotals := totalsSchema(description)
+	for _, name := range []string{"grand_total", "currency_code"} {
+		delete(lineTotals.Attributes, name)
+	}
+	return lineTotals
+}
+
+// Returns the object value of an amount of money.
+func moneyValue(money paddle.Money) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(moneyAttrTypes, map[string]attr.Value{
+		"amount":        customtypes.NewMoneyAmountValue(money.Amount),
+		"currency_code": types.StringValue(string(money.CurrencyCode)),
+	})
+}
+
+// Returns the object value of a duration, null when there is none.
+func durationValue(duration *paddle.Duration) (types.Object, diag.Diagnostics) {
+	if duration == nil {
+		return types.ObjectNull(durationAttrTypes), nil
+	}
+	return types.ObjectValue(durationAttrTypes, map[string]attr.Value{
+		"frequency": types.Int64Value(int64(duration.Frequency)),
+		"interval":  types.StringValue(string(duration.Interval)),
+	})
+}
+
+// Returns the object value of a time period, null when there is none.
+func timePeriodValue(period *paddle.TimePeriod) (types.Object, diag.Diagnostics) {
+	if period == nil {
+		return types.ObjectNull(timePeriodAttrTypes), nil
+	}
+	return types.ObjectValue(timePeriodAttrTypes, map[string]attr.Value{
+		"starts_at": types.StringValue(period.StartsAt),
+		"ends_at":   types.StringValue(period.EndsAt),
+	})
+}
+
+// Returns the object value of transaction totals.
+func totalsValue(totals paddle.TransactionTotals) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(totalsAttrTypes, map[string]attr.Value{
+		"subtotal":      customtypes.NewMoneyAmountValue(totals.Subtotal),
+		"discount":      customtypes.NewMoneyAmountValue(totals.Discount),
+		"tax":           customtypes.NewMoneyAmountValue(totals.Tax),
+		"total":         customtypes.NewMoneyAmountValue(totals.Total),
+		"grand_total":   customtypes.NewMoneyAmountValue(totals.GrandTotal),
+		"currency_code": types.StringValue(string(totals.CurrencyCode)),
+	})
+}
+
+// Returns the object value of the totals of a line item.
+func lineTotalsValue(totals paddle.Totals) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(lineTotalsAttrTypes, map[string]attr.Value{
+		"subtotal": customtypes.NewMoneyAmountValue(totals.Subtotal),
+		"discount": customtypes.NewMoneyAmountValue(totals.Discount),
+		"tax":      customtypes.NewMoneyAmountValue(totals.Tax),
+		"total":    customtypes.NewMoneyAmountValue(totals.Total),
+	})
+}
+
+// Range of creation times that list data sources filter on. The Paddle API
+// cannot filter subscriptions and transactions by creation time, so entities
+// are listed most recent first and filtered here.
+type createdRange struct {
+	from, to time.Time
+}
+
+// Parses the from and to attributes of a list data source. Either may be null.
+func newCreatedRange(from, to customtypes.RFC3339) (createdRange, error) {
+	var r createdRange
+	var err error
+	if !from.IsNull() {
+		if r.from, err = time.Parse(time.RFC3339, from.ValueString()); err != nil {
+			return r, fmt.Errorf("from: %w", err)
+		}
+	}
+	if !to.IsNull() {
+		if r.to, err = time.Parse(time.RFC3339, to.ValueString()); err != nil {
+			return r, fmt.Errorf("to: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// Returns whether an entity created at createdAt is in the range, and whether
+// entities created earlier may still be, so that listing can stop.
+func (r createdRange) check(createdAt string) (in bool, more bool) {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, true
+	}
+	if !r.from.IsZero() && t.Before(r.from) {
+		return false, false
+	}
+	return r.to.IsZero() || t.Before(r.to), true
+}
@@ -0,0 +1,193 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ClientSideTokenDataSource{}
+
+// Creates a new client-side token data source.
+func NewClientSideTokenDataSource() datasource.DataSource {
+	return &ClientSideTokenDataSource{}
+}
+
+// Looks up a Paddle client-side token by ID or by name.
+type ClientSideTokenDataSource struct {
+	client *paddleclient.Client
+}
+
+type clientSideTokenDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Token       types.String `tfsdk:"token"`
+	Status      types.String `tfsdk:"status"`
+	RevokedAt   types.String `tfsdk:"revoked_at"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClientSideTokenDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_side_token"
+}
+
+// Schema returns the data source schema.
+func (d *ClientSideTokenDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Paddle client-side token by ID, or the active client-side token with a name. Exactly one of `id` and `name` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Paddle client-side token ID (format: ctkn_...).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the client-side token. When looking up by name, exactly one active token must have it.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Short description of where this client-side token is used.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Client-side token to pass as `token` when initializing Paddle.js.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of this client-side token. Either `active` or `revoked`.",
+			},
+			"revoked_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the client-side token was revoked. Null when it is active.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the client-side token was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the client-side token was last updated.",
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *ClientSideTokenDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the client-side token from Paddle API.
+func (d *ClientSideTokenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clientSideTokenDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Client-Side Token Lookup",
+			"Exactly one of id and name must be set.",
+		)
+		return
+	}
+
+	var token *paddle.ClientSideToken
+	var err error
+	if !data.ID.IsNull() {
+		token, err = d.client.GetClientToken(ctx, &paddle.GetClientTokenRequest{
+			ClientTokenID: data.ID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading client-side token",
+				fmt.Sprintf("Could not read client-side token ID %s: %s", data.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	} else {
+		token, err = d.findByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Error reading client-side token",
+				fmt.Sprintf("Could not find client-side token '%s': %s", data.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	// Map response to model
+	data.ID = types.StringValue(token.ID)
+	data.Name = types.StringValue(token.Name)
+	data.Description = types.StringPointerValue(token.Description)
+	data.Token = types.StringValue(token.Token)
+	data.Status = types.StringValue(string(token.Status))
+	data.RevokedAt = types.StringPointerValue(token.RevokedAt)
+	data.CreatedAt = types.StringValue(token.CreatedAt)
+	data.UpdatedAt = types.StringValue(token.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Returns the only active client-side token with a name.
+func (d *ClientSideTokenDataSource) findByName(ctx context.Context, name string) (*paddle.ClientSideToken, error) {
+	perPage := 200
+	collection, err := d.client.ListClientTokens(ctx, &paddle.ListClientTokensRequest{
+		Status:  []string{string(paddle.ClientTokenStatusActive)},
+		PerPage: &perPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*paddle.ClientSideToken
+	err = collection.Iter(ctx, func(token *paddle.ClientSideToken) (bool, error) {
+		if token.Name == name {
+			matches = append(matches, token)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no active client-side token is named '%s'", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d active client-side tokens are named '%s', look it up by id instead", len(matches), name)
+	}
+}
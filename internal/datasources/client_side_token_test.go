@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClientSideTokenDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientSideTokenDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.paddle_client_side_token.by_id", "token", "paddle_client_side_token.test", "token"),
+					resource.TestCheckResourceAttrPair("data.paddle_client_side_token.by_name", "id", "paddle_client_side_token.test", "id"),
+					resource.TestCheckResourceAttr("data.paddle_client_side_token.by_name", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientSideTokenDataSourceConfig() string {
+	return `
+resource "paddle_client_side_token" "test" {
+  name        = "Data source test frontend"
+  description = "Looked up by the acceptance tests"
+}
+
+data "paddle_client_side_token" "by_id" {
+  id = paddle_client_side_token.test.id
+}
+
+data "paddle_client_side_token" "by_name" {
+  name = paddle_client_side_token.test.name
+}
+`
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/PaddleHQ/paddle-go-sdk/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -19,19 +21,19 @@ func NewCustomerDataSource() datasource.DataSource {
 }
 
 type CustomerDataSource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type customerDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Email            types.String `tfsdk:"email"`
-	MarketingConsent types.Bool   `tfsdk:"marketing_consent"`
-	Status           types.String `tfsdk:"status"`
-	CustomData       types.Map    `tfsdk:"custom_data"`
-	Locale           types.String `tfsdk:"locale"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ID               types.String       `tfsdk:"id"`
+	Name             types.String       `tfsdk:"name"`
+	Email            types.String       `tfsdk:"email"`
+	MarketingConsent types.Bool         `tfsdk:"marketing_consent"`
+	Status           types.String       `tfsdk:"status"`
+	CustomData       types.Map          `tfsdk:"custom_data"`
+	Locale           customtypes.Locale `tfsdk:"locale"`
+	CreatedAt        types.String       `tfsdk:"created_at"`
+	UpdatedAt        types.String       `tfsdk:"updated_at"`
 }
 
 func (d *CustomerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -70,6 +72,7 @@ func (d *CustomerDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"locale": schema.StringAttribute{
 				Computed:            true,
+				CustomType:          customtypes.LocaleType{},
 				MarkdownDescription: "IETF BCP 47 locale tag.",
 			},
 			"created_at": schema.StringAttribute{
@@ -90,11 +93,11 @@ func (d *CustomerDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -126,7 +129,7 @@ func (d *CustomerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.Email = types.StringValue(customer.Email)
 	data.MarketingConsent = types.BoolValue(customer.MarketingConsent)
 	data.Status = types.StringValue(string(customer.Status))
-	data.Locale = types.StringValue(customer.Locale)
+	data.Locale = customtypes.NewLocaleValue(customer.Locale)
 	data.CreatedAt = types.StringValue(customer.CreatedAt)
 	data.UpdatedAt = types.StringValue(customer.UpdatedAt)
 
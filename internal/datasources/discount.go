@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/PaddleHQ/paddle-go-sdk/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -19,29 +21,29 @@ func NewDiscountDataSource() datasource.DataSource {
 }
 
 type DiscountDataSource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type discountDataSourceModel struct {
-	ID                        types.String `tfsdk:"id"`
-	Status                    types.String `tfsdk:"status"`
-	Description               types.String `tfsdk:"description"`
-	EnabledForCheckout        types.Bool   `tfsdk:"enabled_for_checkout"`
-	Code                      types.String `tfsdk:"code"`
-	Type                      types.String `tfsdk:"type"`
-	Mode                      types.String `tfsdk:"mode"`
-	Amount                    types.String `tfsdk:"amount"`
-	CurrencyCode              types.String `tfsdk:"currency_code"`
-	Recur                     types.Bool   `tfsdk:"recur"`
-	MaximumRecurringIntervals types.Int64  `tfsdk:"maximum_recurring_intervals"`
-	UsageLimit                types.Int64  `tfsdk:"usage_limit"`
-	RestrictTo                types.List   `tfsdk:"restrict_to"`
-	ExpiresAt                 types.String `tfsdk:"expires_at"`
-	CustomData                types.Map    `tfsdk:"custom_data"`
-	TimesUsed                 types.Int64  `tfsdk:"times_used"`
-	DiscountGroupID           types.String `tfsdk:"discount_group_id"`
-	CreatedAt                 types.String `tfsdk:"created_at"`
-	UpdatedAt                 types.String `tfsdk:"updated_at"`
+	ID                        types.String              `tfsdk:"id"`
+	Status                    types.String              `tfsdk:"status"`
+	Description               types.String              `tfsdk:"description"`
+	EnabledForCheckout        types.Bool                `tfsdk:"enabled_for_checkout"`
+	Code                      types.String              `tfsdk:"code"`
+	Type                      types.String              `tfsdk:"type"`
+	Mode                      types.String              `tfsdk:"mode"`
+	Amount                    customtypes.MoneyAmount   `tfsdk:"amount"`
+	CurrencyCode              types.String              `tfsdk:"currency_code"`
+	Recur                     types.Bool                `tfsdk:"recur"`
+	MaximumRecurringIntervals types.Int64               `tfsdk:"maximum_recurring_intervals"`
+	UsageLimit                types.Int64               `tfsdk:"usage_limit"`
+	RestrictTo                customtypes.UnorderedList `tfsdk:"restrict_to"`
+	ExpiresAt                 customtypes.RFC3339       `tfsdk:"expires_at"`
+	CustomData                types.Map                 `tfsdk:"custom_data"`
+	TimesUsed                 types.Int64               `tfsdk:"times_used"`
+	DiscountGroupID           types.String              `tfsdk:"discount_group_id"`
+	CreatedAt                 types.String              `tfsdk:"created_at"`
+	UpdatedAt                 types.String              `tfsdk:"updated_at"`
 }
 
 func (d *DiscountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -83,6 +85,7 @@ func (d *DiscountDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"amount": schema.StringAttribute{
 				Computed:            true,
+				CustomType:          customtypes.MoneyAmountType{},
 				MarkdownDescription: "Amount to discount by.",
 			},
 			"currency_code": schema.StringAttribute{
@@ -104,10 +107,12 @@ func (d *DiscountDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"restrict_to": schema.ListAttribute{
 				Computed:            true,
 				ElementType:         types.StringType,
+				CustomType:          customtypes.NewUnorderedListType(types.StringType),
 				MarkdownDescription: "Product or price IDs this discount applies to.",
 			},
 			"expires_at": schema.StringAttribute{
 				Computed:            true,
+				CustomType:          customtypes.RFC3339Type{},
 				MarkdownDescription: "RFC 3339 datetime when this discount expires.",
 			},
 			"custom_data": schema.MapAttribute{
@@ -140,11 +145,11 @@ func (d *DiscountDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -176,7 +181,7 @@ func (d *DiscountDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.EnabledForCheckout = types.BoolValue(discount.EnabledForCheckout)
 	data.Type = types.StringValue(string(discount.Type))
 	data.Mode = types.StringValue(string(discount.Mode))
-	data.Amount = types.StringValue(discount.Amount)
+	data.Amount = customtypes.NewMoneyAmountValue(discount.Amount)
 	data.Recur = types.BoolValue(discount.Recur)
 	data.TimesUsed = types.Int64Value(int64(discount.TimesUsed))
 	data.CreatedAt = types.StringValue(discount.CreatedAt)
@@ -207,20 +212,20 @@ func (d *DiscountDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if len(discount.RestrictTo) > 0 {
-		restrictToList, diags := types.ListValueFrom(ctx, types.StringType, discount.RestrictTo)
+		restrictToList, diags := customtypes.NewUnorderedListValueFrom(ctx, types.StringType, discount.RestrictTo)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		data.RestrictTo = restrictToList
 	} else {
-		data.RestrictTo = types.ListNull(types.StringType)
+		data.RestrictTo = customtypes.NewUnorderedListNull(types.StringType)
 	}
 
 	if discount.ExpiresAt != nil {
-		data.ExpiresAt = types.StringValue(*discount.ExpiresAt)
+		data.ExpiresAt = customtypes.NewRFC3339Value(*discount.ExpiresAt)
 	} else {
-		data.ExpiresAt = types.StringNull()
+		data.ExpiresAt = customtypes.NewRFC3339Null()
 	}
 
 	if discount.CustomData != nil {
@@ -0,0 +1,173 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EventTypesDataSource{}
+
+// Creates a new event types data source.
+func NewEventTypesDataSource() datasource.DataSource {
+	return &EventTypesDataSource{}
+}
+
+// The event types Paddle can send to notification settings.
+type EventTypesDataSource struct {
+	client *paddleclient.Client
+}
+
+type eventTypesDataSourceModel struct {
+	Group      types.String `tfsdk:"group"`
+	Names      types.List   `tfsdk:"names"`
+	EventTypes types.List   `tfsdk:"event_types"`
+}
+
+type eventTypeModel struct {
+	Name              types.String `tfsdk:"name"`
+	Group             types.String `tfsdk:"group"`
+	Description       types.String `tfsdk:"description"`
+	AvailableVersions types.List   `tfsdk:"available_versions"`
+}
+
+var eventTypeAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"group":              types.StringType,
+	"description":        types.StringType,
+	"available_versions": types.ListType{ElemType: types.Int64Type},
+}
+
+// Metadata returns the data source type name.
+func (d *EventTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_types"
+}
+
+// Schema returns the data source schema.
+func (d *EventTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the event types Paddle can send to notification settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only the event types of this group, such as `Subscription`. Case-insensitive.",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the event types, ready to use in `subscribed_events`.",
+			},
+			"event_types": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Event types Paddle can send.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the event type, in the format `entity.event_type`.",
+						},
+						"group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Group of the event type, typically the entity it relates to.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Short description of the event type.",
+						},
+						"available_versions": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "API versions the event type supports.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *EventTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the event types from Paddle API.
+func (d *EventTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data eventTypesDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// List event types from Paddle API
+	eventTypes, err := d.client.ListAllEventTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading event types",
+			fmt.Sprintf("Could not list event types: %s", err.Error()),
+		)
+		return
+	}
+
+	// Map response to model, keeping the event types of the requested group
+	names := []string{}
+	models := []eventTypeModel{}
+	for _, eventType := range eventTypes {
+		if !data.Group.IsNull() && !strings.EqualFold(eventType.Group, data.Group.ValueString()) {
+			continue
+		}
+
+		versions := make([]int64, len(eventType.AvailableVersions))
+		for i, version := range eventType.AvailableVersions {
+			versions[i] = int64(version)
+		}
+		availableVersions, diags := types.ListValueFrom(ctx, types.Int64Type, versions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		names = append(names, string(eventType.Name))
+		models = append(models, eventTypeModel{
+			Name:              types.StringValue(string(eventType.Name)),
+			Group:             types.StringValue(eventType.Group),
+			Description:       types.StringValue(eventType.Description),
+			AvailableVersions: availableVersions,
+		})
+	}
+
+	namesValue, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	eventTypesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: eventTypeAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Names = namesValue
+	data.EventTypes = eventTypesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEventTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.paddle_event_types.all", "event_types.0.name"),
+					resource.TestCheckResourceAttrSet("data.paddle_event_types.all", "event_types.0.available_versions.0"),
+					resource.TestCheckTypeSetElemAttr("data.paddle_event_types.subscription", "names.*", "subscription.created"),
+					resource.TestCheckResourceAttr("data.paddle_event_types.subscription", "event_types.0.group", "Subscription"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEventTypesDataSourceConfig() string {
+	return `
+data "paddle_event_types" "all" {}
+
+data "paddle_event_types" "subscription" {
+  group = "subscription"
+}
+`
+}
@@ -0,0 +1,118 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &IPAddressesDataSource{}
+
+// Creates a new IP addresses data source.
+func NewIPAddressesDataSource() datasource.DataSource {
+	return &IPAddressesDataSource{}
+}
+
+// The IP addresses Paddle sends webhooks from.
+type IPAddressesDataSource struct {
+	client *paddleclient.Client
+}
+
+type ipAddressesDataSourceModel struct {
+	Environment types.String `tfsdk:"environment"`
+	IPv4CIDRs   types.List   `tfsdk:"ipv4_cidrs"`
+}
+
+// Metadata returns the data source type name.
+func (d *IPAddressesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_addresses"
+}
+
+// Schema returns the data source schema.
+func (d *IPAddressesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the IP addresses Paddle sends webhooks from in the environment of the provider, to allowlist them in firewalls.",
+
+		Attributes: map[string]schema.Attribute{
+			"environment": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle environment the IP addresses belong to: `sandbox` or `production`.",
+			},
+			"ipv4_cidrs": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IPv4 CIDR blocks Paddle sends webhooks from, sorted. Single addresses are returned as `/32` blocks.",
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *IPAddressesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the IP addresses from Paddle API.
+func (d *IPAddressesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ipAddressesDataSourceModel
+
+	// Get IP addresses from Paddle API
+	ipAddresses, err := d.client.GetIPAddresses(ctx, &paddle.GetIPAddressesRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IP addresses",
+			fmt.Sprintf("Could not read Paddle IP addresses: %s", err.Error()),
+		)
+		return
+	}
+
+	// Normalize the addresses into CIDR blocks, so they can be used as is in firewall rules
+	cidrs := make([]string, 0, len(ipAddresses.IPv4CIDRs))
+	for _, address := range ipAddresses.IPv4CIDRs {
+		if !strings.Contains(address, "/") {
+			address += "/32"
+		}
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil || !prefix.Addr().Is4() {
+			resp.Diagnostics.AddError(
+				"Error reading IP addresses",
+				fmt.Sprintf("Paddle returned %q, which is not an IPv4 CIDR block. Please report this issue to the provider developers.", address),
+			)
+			return
+		}
+		cidrs = append(cidrs, prefix.Masked().String())
+	}
+	sort.Strings(cidrs)
+
+	cidrsValue, diags := types.ListValueFrom(ctx, types.StringType, cidrs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Environment = types.StringValue(d.client.Environment)
+	data.IPv4CIDRs = cidrsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,29 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPAddressesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAddressesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.paddle_ip_addresses.test", "environment"),
+					resource.TestCheckResourceAttrSet("data.paddle_ip_addresses.test", "ipv4_cidrs.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPAddressesDataSourceConfig() string {
+	return `
+data "paddle_ip_addresses" "test" {}
+`
+}
@@ -0,0 +1,260 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NotificationSettingHealthDataSource{}
+
+// Window the health is computed over when window is not set.
+const defaultNotificationSettingHealthWindow = "24h"
+
+// Creates a new notification setting health data source.
+func NewNotificationSettingHealthDataSource() datasource.DataSource {
+	return &NotificationSettingHealthDataSource{}
+}
+
+// The delivery health of a notification setting, computed from the
+// notifications Paddle sent to it.
+type NotificationSettingHealthDataSource struct {
+	client *paddleclient.Client
+}
+
+type notificationSettingHealthDataSourceModel struct {
+	NotificationSettingID types.String  `tfsdk:"notification_setting_id"`
+	Window                types.String  `tfsdk:"window"`
+	From                  types.String  `tfsdk:"from"`
+	Notifications         types.Int64   `tfsdk:"notifications"`
+	Delivered             types.Int64   `tfsdk:"delivered"`
+	Failed                types.Int64   `tfsdk:"failed"`
+	Pending               types.Int64   `tfsdk:"pending"`
+	FailureRate           types.Float64 `tfsdk:"failure_rate"`
+	ConsecutiveFailures   types.Int64   `tfsdk:"consecutive_failures"`
+	LastDeliveredAt       types.String  `tfsdk:"last_delivered_at"`
+	LastFailedAt          types.String  `tfsdk:"last_failed_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *NotificationSettingHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_setting_health"
+}
+
+// Schema returns the data source schema.
+func (d *NotificationSettingHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports how well Paddle delivers notifications to a notification setting over a time window. Meant for `check` blocks, so that plans warn about destinations that have been failing.",
+
+		Attributes: map[string]schema.Attribute{
+			"notification_setting_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Paddle notification setting ID (format: ntfset_...).",
+			},
+			"window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How far back to look at notifications, as a duration such as `1h` or `72h`. Defaults to `%s`.", defaultNotificationSettingHealthWindow),
+			},
+			"from": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the window starts.",
+			},
+			"notifications": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of notifications that occurred in the window.",
+			},
+			"delivered": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of notifications in the window that were delivered.",
+			},
+			"failed": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of notifications in the window whose last delivery attempt failed, whether Paddle still retries them (`needs_retry`) or gave up (`failed`).",
+			},
+			"pending": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of notifications in the window that Paddle has not attempted to deliver yet.",
+			},
+			"failure_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Share of the attempted notifications in the window that failed, between 0 and 1. 0 when no notification was attempted.",
+			},
+			"consecutive_failures": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of the most recent attempted notifications in the window that failed since the last delivered one.",
+			},
+			"last_delivered_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of the last successful delivery, even before the window. Null when no notification was ever delivered.",
+			},
+			"last_failed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of the last failed delivery attempt in the window. Null when none failed.",
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *NotificationSettingHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read computes the delivery health from the notifications in the window.
+func (d *NotificationSettingHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data notificationSettingHealthDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := defaultNotificationSettingHealthWindow
+	if !data.Window.IsNull() {
+		window = data.Window.ValueString()
+	}
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Invalid Window",
+			fmt.Sprintf("window must be a positive duration, such as 1h or 72h. Got: %s", window),
+		)
+		return
+	}
+	from := time.Now().UTC().Add(-duration).Format(time.RFC3339)
+	settingID := data.NotificationSettingID.ValueString()
+
+	// List the notifications of the window from Paddle API, most recent first
+	perPage := 200
+	orderBy := "id[DESC]"
+	var notifications []*paddle.Notification
+	collection, err := d.client.ListNotifications(ctx, &paddle.ListNotificationsRequest{
+		NotificationSettingID: []string{settingID},
+		OrderBy:               &orderBy,
+		PerPage:               &perPage,
+		From:                  &from,
+	})
+	if err == nil {
+		err = collection.Iter(ctx, func(notification *paddle.Notification) (bool, error) {
+			notifications = append(notifications, notification)
+			return true, nil
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification setting health",
+			fmt.Sprintf("Could not list notifications for notification setting ID %s: %s", settingID, err.Error()),
+		)
+		return
+	}
+
+	health := countNotificationDeliveries(notifications)
+
+	// The last delivery may be older than the window, so it is read separately
+	lastDeliveredAt, err := d.lastDeliveredAt(ctx, settingID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification setting health",
+			fmt.Sprintf("Could not read the last delivered notification for notification setting ID %s: %s", settingID, err.Error()),
+		)
+		return
+	}
+
+	// Map results to model
+	data.From = types.StringValue(from)
+	data.Notifications = types.Int64Value(int64(len(notifications)))
+	data.Delivered = types.Int64Value(health.delivered)
+	data.Failed = types.Int64Value(health.failed)
+	data.Pending = types.Int64Value(health.pending)
+	data.FailureRate = types.Float64Value(health.failureRate)
+	data.ConsecutiveFailures = types.Int64Value(health.consecutiveFailures)
+	data.LastDeliveredAt = types.StringPointerValue(lastDeliveredAt)
+	data.LastFailedAt = types.StringPointerValue(health.lastFailedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delivery counts of the notifications of a window.
+type notificationDeliveryHealth struct {
+	delivered           int64
+	failed              int64
+	pending             int64
+	failureRate         float64
+	consecutiveFailures int64
+	lastFailedAt        *string
+}
+
+// Counts deliveries and failures of notifications ordered most recent first.
+// Failures only count as consecutive until the most recent delivered
+// notification, and notifications not attempted yet do not break the streak.
+func countNotificationDeliveries(notifications []*paddle.Notification) notificationDeliveryHealth {
+	var health notificationDeliveryHealth
+	failing := true
+	for _, notification := range notifications {
+		switch notification.Status {
+		case paddle.NotificationStatusDelivered:
+			health.delivered++
+			failing = false
+		case paddle.NotificationStatusFailed, paddle.NotificationStatusNeedsRetry:
+			health.failed++
+			if failing {
+				health.consecutiveFailures++
+			}
+			if health.lastFailedAt == nil || (notification.LastAttemptAt != nil && *notification.LastAttemptAt > *health.lastFailedAt) {
+				health.lastFailedAt = notification.LastAttemptAt
+			}
+		default:
+			health.pending++
+		}
+	}
+	if attempted := health.delivered + health.failed; attempted > 0 {
+		health.failureRate = float64(health.failed) / float64(attempted)
+	}
+	return health
+}
+
+// Returns when the most recent delivered notification of a notification setting
+// was delivered, or nil when none was.
+func (d *NotificationSettingHealthDataSource) lastDeliveredAt(ctx context.Context, settingID string) (*string, error) {
+	perPage := 1
+	orderBy := "id[DESC]"
+	collection, err := d.client.ListNotifications(ctx, &paddle.ListNotificationsRequest{
+		NotificationSettingID: []string{settingID},
+		Status:                []string{string(paddle.NotificationStatusDelivered)},
+		OrderBy:               &orderBy,
+		PerPage:               &perPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveredAt *string
+	err = collection.Iter(ctx, func(notification *paddle.Notification) (bool, error) {
+		deliveredAt = notification.DeliveredAt
+		return false, nil
+	})
+	return deliveredAt, err
+}
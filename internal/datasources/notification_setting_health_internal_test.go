@@ -0,0 +1,119 @@
+package datasources
+
+import (
+	"testing"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Returns a notification with the given status, last attempted at lastAttemptAt
+// when it is not empty.
+func testNotification(status paddle.NotificationStatus, lastAttemptAt string) *paddle.Notification {
+	notification := &paddle.Notification{Status: status}
+	if lastAttemptAt != "" {
+		notification.LastAttemptAt = &lastAttemptAt
+	}
+	return notification
+}
+
+func TestCountNotificationDeliveries(t *testing.T) {
+	tests := []struct {
+		name                      string
+		notifications             []*paddle.Notification
+		expectDelivered           int64
+		expectFailed              int64
+		expectPending             int64
+		expectFailureRate         float64
+		expectConsecutiveFailures int64
+		expectLastFailedAt        string
+	}{
+		{
+			name: "empty window",
+		},
+		{
+			name: "pending only",
+			notifications: []*paddle.Notification{
+				testNotification(paddle.NotificationStatusNotAttempted, ""),
+				testNotification(paddle.NotificationStatusNotAttempted, ""),
+			},
+			expectPending: 2,
+		},
+		{
+			name: "failures since the last delivery",
+			notifications: []*paddle.Notification{
+				testNotification(paddle.NotificationStatusFailed, "2024-01-01T00:03:00Z"),
+				testNotification(paddle.NotificationStatusFailed, "2024-01-01T00:02:00Z"),
+				testNotification(paddle.NotificationStatusDelivered, "2024-01-01T00:01:00Z"),
+				testNotification(paddle.NotificationStatusFailed, "2024-01-01T00:00:00Z"),
+			},
+			expectDelivered:           1,
+			expectFailed:              3,
+			expectFailureRate:         0.75,
+			expectConsecutiveFailures: 2,
+			expectLastFailedAt:        "2024-01-01T00:03:00Z",
+		},
+		{
+			name: "delivered most recently",
+			notifications: []*paddle.Notification{
+				testNotification(paddle.NotificationStatusDelivered, "2024-01-01T00:01:00Z"),
+				testNotification(paddle.NotificationStatusFailed, "2024-01-01T00:00:00Z"),
+			},
+			expectDelivered:    1,
+			expectFailed:       1,
+			expectFailureRate:  0.5,
+			expectLastFailedAt: "2024-01-01T00:00:00Z",
+		},
+		{
+			name: "needs retry counts as failed",
+			notifications: []*paddle.Notification{
+				testNotification(paddle.NotificationStatusNeedsRetry, "2024-01-01T00:01:00Z"),
+				testNotification(paddle.NotificationStatusNeedsRetry, "2024-01-01T00:02:00Z"),
+			},
+			expectFailed:              2,
+			expectFailureRate:         1,
+			expectConsecutiveFailures: 2,
+			expectLastFailedAt:        "2024-01-01T00:02:00Z",
+		},
+		{
+			name: "pending does not break the streak",
+			notifications: []*paddle.Notification{
+				testNotification(paddle.NotificationStatusNotAttempted, ""),
+				testNotification(paddle.NotificationStatusNeedsRetry, "2024-01-01T00:02:00Z"),
+				testNotification(paddle.NotificationStatusNotAttempted, ""),
+				testNotification(paddle.NotificationStatusFailed, "2024-01-01T00:01:00Z"),
+				testNotification(paddle.NotificationStatusDelivered, "2024-01-01T00:00:00Z"),
+			},
+			expectDelivered:           1,
+			expectFailed:              2,
+			expectPending:             2,
+			expectFailureRate:         2.0 / 3.0,
+			expectConsecutiveFailures: 2,
+			expectLastFailedAt:        "2024-01-01T00:02:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := countNotificationDeliveries(tt.notifications)
+
+			if health.delivered != tt.expectDelivered || health.failed != tt.expectFailed || health.pending != tt.expectPending {
+				t.Errorf("expected %d delivered, %d failed and %d pending, got %d, %d and %d",
+					tt.expectDelivered, tt.expectFailed, tt.expectPending, health.delivered, health.failed, health.pending)
+			}
+			if health.failureRate != tt.expectFailureRate {
+				t.Errorf("expected failure rate %v but got %v", tt.expectFailureRate, health.failureRate)
+			}
+			if health.consecutiveFailures != tt.expectConsecutiveFailures {
+				t.Errorf("expected %d consecutive failures but got %d", tt.expectConsecutiveFailures, health.consecutiveFailures)
+			}
+
+			lastFailedAt := ""
+			if health.lastFailedAt != nil {
+				lastFailedAt = *health.lastFailedAt
+			}
+			if lastFailedAt != tt.expectLastFailedAt {
+				t.Errorf("expected last failed at %q but got %q", tt.expectLastFailedAt, lastFailedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationSettingHealthDataSource(t *testing.T) {
+	notificationSettingID := os.Getenv("PADDLE_NOTIFICATION_SETTING_ID")
+	if notificationSettingID == "" {
+		t.Skip("PADDLE_NOTIFICATION_SETTING_ID must be set to read notification setting health")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSettingHealthDataSourceConfig(notificationSettingID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_notification_setting_health.test", "window", "72h"),
+					resource.TestCheckResourceAttrSet("data.paddle_notification_setting_health.test", "from"),
+					resource.TestCheckResourceAttrSet("data.paddle_notification_setting_health.test", "notifications"),
+					resource.TestCheckResourceAttrSet("data.paddle_notification_setting_health.test", "failure_rate"),
+					resource.TestCheckResourceAttrSet("data.paddle_notification_setting_health.test", "consecutive_failures"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSettingHealthDataSourceConfig(notificationSettingID string) string {
+	return `
+data "paddle_notification_setting_health" "test" {
+  notification_setting_id = "` + notificationSettingID + `"
+  window                  = "72h"
+}
+`
+}
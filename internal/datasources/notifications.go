@@ -0,0 +1,388 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NotificationsDataSource{}
+
+// Number of notifications returned when limit is not set.
+const defaultNotificationsLimit = 200
+
+// Creates a new notifications data source.
+func NewNotificationsDataSource() datasource.DataSource {
+	return &NotificationsDataSource{}
+}
+
+// The notifications Paddle sent to a notification setting.
+type NotificationsDataSource struct {
+	client *paddleclient.Client
+}
+
+type notificationsDataSourceModel struct {
+	NotificationSettingID types.String        `tfsdk:"notification_setting_id"`
+	Status                types.List          `tfsdk:"status"`
+	EventTypes            types.List          `tfsdk:"event_types"`
+	From                  customtypes.RFC3339 `tfsdk:"from"`
+	To                    customtypes.RFC3339 `tfsdk:"to"`
+	IncludeAttempts       types.Bool          `tfsdk:"include_attempts"`
+	Limit                 types.Int64         `tfsdk:"limit"`
+	IDs                   types.List          `tfsdk:"ids"`
+	Notifications         types.List          `tfsdk:"notifications"`
+}
+
+type notificationModel struct {
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	Status           types.String `tfsdk:"status"`
+	Origin           types.String `tfsdk:"origin"`
+	OccurredAt       types.String `tfsdk:"occurred_at"`
+	DeliveredAt      types.String `tfsdk:"delivered_at"`
+	ReplayedAt       types.String `tfsdk:"replayed_at"`
+	LastAttemptAt    types.String `tfsdk:"last_attempt_at"`
+	RetryAt          types.String `tfsdk:"retry_at"`
+	TimesAttempted   types.Int64  `tfsdk:"times_attempted"`
+	LastResponseCode types.Int64  `tfsdk:"last_response_code"`
+	Attempts         types.List   `tfsdk:"attempts"`
+}
+
+type notificationAttemptModel struct {
+	ID           types.String `tfsdk:"id"`
+	ResponseCode types.Int64  `tfsdk:"response_code"`
+	AttemptedAt  types.String `tfsdk:"attempted_at"`
+}
+
+var notificationAttemptAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"response_code": types.Int64Type,
+	"attempted_at":  types.StringType,
+}
+
+var notificationAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"type":               types.StringType,
+	"status":             types.StringType,
+	"origin":             types.StringType,
+	"occurred_at":        types.StringType,
+	"delivered_at":       types.StringType,
+	"replayed_at":        types.StringType,
+	"last_attempt_at":    types.StringType,
+	"retry_at":           types.StringType,
+	"times_attempted":    types.Int64Type,
+	"last_response_code": types.Int64Type,
+	"attempts":           types.ListType{ElemType: types.ObjectType{AttrTypes: notificationAttemptAttrTypes}},
+}
+
+// Metadata returns the data source type name.
+func (d *NotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+// Schema returns the data source schema.
+func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the notifications Paddle sent to a notification setting, most recent first, with their delivery attempts.",
+
+		Attributes: map[string]schema.Attribute{
+			"notification_setting_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Paddle notification setting ID (format: ntfset_...).",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only notifications with these statuses: `not_attempted`, `needs_retry`, `delivered` or `failed`.",
+			},
+			"event_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only notifications of these event types. Wildcards such as `subscription.*` match every event type of an entity.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only notifications that occurred at or after this RFC 3339 timestamp.",
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only notifications that occurred before this RFC 3339 timestamp.",
+			},
+			"include_attempts": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to read the delivery attempts of each notification, with their response codes. Costs one API call per notification. Defaults to false.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of notifications to return. Must be at least 1. Defaults to %d.", defaultNotificationsLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the notifications, ready to use in `paddle_notification_replay`.",
+			},
+			"notifications": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Notifications matching the filters, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Paddle notification ID (format: ntf_...).",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Event type of the notification.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the notification: `not_attempted`, `needs_retry`, `delivered` or `failed`.",
+						},
+						"origin": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "How the notification was created: `event` or `replay`.",
+						},
+						"occurred_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp when the notification occurred.",
+						},
+						"delivered_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp when the notification was delivered. Null if not delivered yet.",
+						},
+						"replayed_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp when the notification was replayed. Null if not replayed.",
+						},
+						"last_attempt_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp of the last delivery attempt.",
+						},
+						"retry_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp when the next delivery attempt is scheduled.",
+						},
+						"times_attempted": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of delivery attempts.",
+						},
+						"last_response_code": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "HTTP status code of the last delivery attempt. Only set when `include_attempts` is true.",
+						},
+						"attempts": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Delivery attempts, most recent first. Only set when `include_attempts` is true.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Paddle notification log ID (format: ntflog_...).",
+									},
+									"response_code": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "HTTP status code sent by the destination.",
+									},
+									"attempted_at": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "RFC 3339 timestamp of the attempt.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *NotificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the notifications from Paddle API.
+func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data notificationsDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses, eventTypePatterns []string
+	if !data.Status.IsNull() {
+		resp.Diagnostics.Append(data.Status.ElementsAs(ctx, &statuses, false)...)
+	}
+	if !data.EventTypes.IsNull() {
+		resp.Diagnostics.Append(data.EventTypes.ElementsAs(ctx, &eventTypePatterns, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultNotificationsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	// Event types are filtered here, as the Paddle API only filters by status and time
+	var eventTypes map[string]bool
+	if len(eventTypePatterns) > 0 {
+		known := d.client.KnownEventTypes(ctx)
+		names, unknown := eventtypes.Expand(eventTypePatterns, known)
+		for _, pattern := range unknown {
+			resp.Diagnostics.AddAttributeWarning(path.Root("event_types"), "Unknown Event Type", eventtypes.Hint(pattern, known))
+		}
+		eventTypes = make(map[string]bool, len(names))
+		for _, name := range names {
+			eventTypes[name] = true
+		}
+	}
+
+	// List notifications from Paddle API
+	perPage := 200
+	orderBy := "id[DESC]"
+	listReq := &paddle.ListNotificationsRequest{
+		NotificationSettingID: []string{data.NotificationSettingID.ValueString()},
+		Status:                statuses,
+		OrderBy:               &orderBy,
+		PerPage:               &perPage,
+		From:                  data.From.ValueStringPointer(),
+		To:                    data.To.ValueStringPointer(),
+	}
+
+	var notifications []*paddle.Notification
+	collection, err := d.client.ListNotifications(ctx, listReq)
+	if err == nil {
+		err = collection.Iter(ctx, func(notification *paddle.Notification) (bool, error) {
+			if eventTypes == nil || eventTypes[string(notification.Type)] {
+				notifications = append(notifications, notification)
+			}
+			return int64(len(notifications)) < limit, nil
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notifications",
+			fmt.Sprintf("Could not list notifications for notification setting ID %s: %s", data.NotificationSettingID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	ids := make([]string, 0, len(notifications))
+	models := make([]notificationModel, 0, len(notifications))
+	for _, notification := range notifications {
+		model := notificationModel{
+			ID:               types.StringValue(notification.ID),
+			Type:             types.StringValue(string(notification.Type)),
+			Status:           types.StringValue(string(notification.Status)),
+			Origin:           types.StringValue(string(notification.Origin)),
+			OccurredAt:       types.StringValue(notification.OccurredAt),
+			DeliveredAt:      types.StringPointerValue(notification.DeliveredAt),
+			ReplayedAt:       types.StringPointerValue(notification.ReplayedAt),
+			LastAttemptAt:    types.StringPointerValue(notification.LastAttemptAt),
+			RetryAt:          types.StringPointerValue(notification.RetryAt),
+			TimesAttempted:   types.Int64Value(int64(notification.TimesAttempted)),
+			LastResponseCode: types.Int64Null(),
+			Attempts:         types.ListNull(types.ObjectType{AttrTypes: notificationAttemptAttrTypes}),
+		}
+
+		if data.IncludeAttempts.ValueBool() {
+			attempts, err := d.readAttempts(ctx, notification.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error reading notification attempts",
+					fmt.Sprintf("Could not list the delivery attempts of notification ID %s: %s", notification.ID, err.Error()),
+				)
+				return
+			}
+			if len(attempts) > 0 {
+				model.LastResponseCode = attempts[0].ResponseCode
+			}
+
+			attemptsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: notificationAttemptAttrTypes}, attempts)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			model.Attempts = attemptsValue
+		}
+
+		ids = append(ids, notification.ID)
+		models = append(models, model)
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	notificationsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: notificationAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsValue
+	data.Notifications = notificationsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Reads the delivery attempts of a notification, most recent first.
+func (d *NotificationsDataSource) readAttempts(ctx context.Context, notificationID string) ([]notificationAttemptModel, error) {
+	perPage := 200
+	logs, err := d.client.ListNotificationLogs(ctx, &paddle.ListNotificationLogsRequest{
+		NotificationID: notificationID,
+		PerPage:        &perPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var attempts []notificationAttemptModel
+	err = logs.Iter(ctx, func(log *paddle.NotificationLog) (bool, error) {
+		attempts = append(attempts, notificationAttemptModel{
+			ID:           types.StringValue(log.ID),
+			ResponseCode: types.Int64Value(int64(log.ResponseCode)),
+			AttemptedAt:  types.StringValue(log.AttemptedAt),
+		})
+		return true, nil
+	})
+
+	sort.SliceStable(attempts, func(i, j int) bool {
+		return attempts[i].AttemptedAt.ValueString() > attempts[j].AttemptedAt.ValueString()
+	})
+	return attempts, err
+}
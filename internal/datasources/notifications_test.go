@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationsDataSource(t *testing.T) {
+	notificationSettingID := os.Getenv("PADDLE_NOTIFICATION_SETTING_ID")
+	if notificationSettingID == "" {
+		t.Skip("PADDLE_NOTIFICATION_SETTING_ID must be set to read notifications")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationsDataSourceConfig(notificationSettingID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_notifications.test", "notification_setting_id", notificationSettingID),
+					resource.TestCheckResourceAttrSet("data.paddle_notifications.test", "notifications.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationsDataSourceConfig(notificationSettingID string) string {
+	return `
+data "paddle_notifications" "test" {
+  notification_setting_id = "` + notificationSettingID + `"
+  status                  = ["delivered", "failed"]
+  event_types             = ["transaction.*"]
+  include_attempts        = true
+  limit                   = 10
+}
+`
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
-	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,7 +22,7 @@ func NewPriceDataSource() datasource.DataSource {
 
 // A single Paddle price.
 type PriceDataSource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type priceDataSourceModel struct {
@@ -78,6 +79,7 @@ func (d *PriceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"amount": schema.StringAttribute{
 						Computed:            true,
+						CustomType:          customtypes.MoneyAmountType{},
 						MarkdownDescription: "Amount in cents as a string.",
 					},
 					"currency_code": schema.StringAttribute{
@@ -94,6 +96,7 @@ func (d *PriceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						"country_codes": schema.ListAttribute{
 							Computed:            true,
 							ElementType:         types.StringType,
+							CustomType:          customtypes.NewUnorderedListType(types.StringType),
 							MarkdownDescription: "List of country codes.",
 						},
 						"unit_price": schema.SingleNestedAttribute{
@@ -102,6 +105,7 @@ func (d *PriceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Attributes: map[string]schema.Attribute{
 								"amount": schema.StringAttribute{
 									Computed:            true,
+									CustomType:          customtypes.MoneyAmountType{},
 									MarkdownDescription: "Amount in cents.",
 								},
 								"currency_code": schema.StringAttribute{
@@ -182,11 +186,11 @@ func (d *PriceDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -205,9 +209,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get price from Paddle API
-	price, err := d.client.GetPrice(ctx, &paddle.GetPriceRequest{
-		PriceID: data.ID.ValueString(),
-	})
+	price, err := d.client.ReadPrice(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading price",
@@ -234,11 +236,11 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// Map unit_price
 	unitPriceAttrTypes := map[string]attr.Type{
-		"amount":        types.StringType,
+		"amount":        customtypes.MoneyAmountType{},
 		"currency_code": types.StringType,
 	}
 	unitPriceObj, diags := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
-		"amount":        types.StringValue(price.UnitPrice.Amount),
+		"amount":        customtypes.NewMoneyAmountValue(price.UnitPrice.Amount),
 		"currency_code": types.StringValue(string(price.UnitPrice.CurrencyCode)),
 	})
 	resp.Diagnostics.Append(diags...)
@@ -256,7 +258,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			for i, code := range override.CountryCodes {
 				countryCodeValues[i] = types.StringValue(string(code))
 			}
-			countryCodesList, diags := types.ListValue(types.StringType, countryCodeValues)
+			countryCodesList, diags := customtypes.NewUnorderedListValue(types.StringType, countryCodeValues)
 			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
 				return
@@ -264,7 +266,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 			// Create unit price object
 			overrideUnitPriceObj, diags := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
-				"amount":        types.StringValue(override.UnitPrice.Amount),
+				"amount":        customtypes.NewMoneyAmountValue(override.UnitPrice.Amount),
 				"currency_code": types.StringValue(string(override.UnitPrice.CurrencyCode)),
 			})
 			resp.Diagnostics.Append(diags...)
@@ -274,7 +276,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 			// Create override object
 			overrideAttrTypes := map[string]attr.Type{
-				"country_codes": types.ListType{ElemType: types.StringType},
+				"country_codes": customtypes.NewUnorderedListType(types.StringType),
 				"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
 			}
 			overrideObj, diags := types.ObjectValue(overrideAttrTypes, map[string]attr.Value{
@@ -290,7 +292,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		}
 
 		overrideAttrTypes := map[string]attr.Type{
-			"country_codes": types.ListType{ElemType: types.StringType},
+			"country_codes": customtypes.NewUnorderedListType(types.StringType),
 			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
 		}
 		overridesList, diags := types.ListValue(types.ObjectType{AttrTypes: overrideAttrTypes}, overrideElements)
@@ -301,7 +303,7 @@ func (d *PriceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.UnitPriceOverrides = overridesList
 	} else {
 		overrideAttrTypes := map[string]attr.Type{
-			"country_codes": types.ListType{ElemType: types.StringType},
+			"country_codes": customtypes.NewUnorderedListType(types.StringType),
 			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
 		}
 		data.UnitPriceOverrides = types.ListNull(types.ObjectType{AttrTypes: overrideAttrTypes})
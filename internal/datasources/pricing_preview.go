@@ -0,0 +1,304 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PricingPreviewDataSource{}
+
+// Creates a new pricing preview data source.
+func NewPricingPreviewDataSource() datasource.DataSource {
+	return &PricingPreviewDataSource{}
+}
+
+// The localized totals of prices for a location, as Paddle.js shows them.
+type PricingPreviewDataSource struct {
+	client *paddleclient.Client
+}
+
+type pricingPreviewDataSourceModel struct {
+	Items        types.List   `tfsdk:"items"`
+	CountryCode  types.String `tfsdk:"country_code"`
+	PostalCode   types.String `tfsdk:"postal_code"`
+	DiscountID   types.String `tfsdk:"discount_id"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	LineItems    types.List   `tfsdk:"line_items"`
+}
+
+type pricingPreviewItemModel struct {
+	PriceID  types.String `tfsdk:"price_id"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+}
+
+type pricingPreviewLineItemModel struct {
+	PriceID             types.String `tfsdk:"price_id"`
+	ProductID           types.String `tfsdk:"product_id"`
+	Quantity            types.Int64  `tfsdk:"quantity"`
+	TaxRate             types.String `tfsdk:"tax_rate"`
+	UnitTotals          types.Object `tfsdk:"unit_totals"`
+	Totals              types.Object `tfsdk:"totals"`
+	FormattedUnitTotals types.Object `tfsdk:"formatted_unit_totals"`
+	FormattedTotals     types.Object `tfsdk:"formatted_totals"`
+}
+
+// Attribute types of totals formatted in their currency, such as "$10.00".
+var formattedTotalsAttrTypes = map[string]attr.Type{
+	"subtotal": types.StringType,
+	"discount": types.StringType,
+	"tax":      types.StringType,
+	"total":    types.StringType,
+}
+
+var pricingPreviewLineItemAttrTypes = map[string]attr.Type{
+	"price_id":              types.StringType,
+	"product_id":            types.StringType,
+	"quantity":              types.Int64Type,
+	"tax_rate":              types.StringType,
+	"unit_totals":           types.ObjectType{AttrTypes: lineTotalsAttrTypes},
+	"totals":                types.ObjectType{AttrTypes: lineTotalsAttrTypes},
+	"formatted_unit_totals": types.ObjectType{AttrTypes: formattedTotalsAttrTypes},
+	"formatted_totals":      types.ObjectType{AttrTypes: formattedTotalsAttrTypes},
+}
+
+// Metadata returns the data source type name.
+func (d *PricingPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pricing_preview"
+}
+
+// Schema returns the data source schema.
+func (d *PricingPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	formattedTotals := func(description string) schema.SingleNestedAttribute {
+		formatted := func(description string) schema.StringAttribute {
+			return schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: description + " Formatted in the currency, such as `$10.00`.",
+			}
+		}
+		return schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+			Attributes: map[string]schema.Attribute{
+				"subtotal": formatted("Total before discount and tax."),
+				"discount": formatted("Total discount."),
+				"tax":      formatted("Total tax on the subtotal."),
+				"total":    formatted("Total after discount and tax."),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Previews the localized totals of prices for a country, using the Paddle pricing preview API.",
+
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Prices to preview.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"price_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Paddle price ID (format: pri_...).",
+						},
+						"quantity": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Quantity of the price. Defaults to 1.",
+						},
+					},
+				},
+			},
+			"country_code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Two-letter ISO 3166-1 alpha-2 code of the country to calculate tax and localize prices for.",
+				Validators: []validator.String{
+					validators.CountryCodeValidator{},
+				},
+			},
+			"postal_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ZIP or postal code, for more accurate tax calculations.",
+			},
+			"discount_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Paddle ID of a discount to apply (format: dsc_...).",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Three-letter ISO 4217 currency code to preview in. Defaults to the currency Paddle picks for the country.",
+				Validators: []validator.String{
+					validators.CurrencyCodeValidator{},
+				},
+			},
+			"line_items": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Totals of each item, in the order of items.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"price_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Paddle price ID (format: pri_...).",
+						},
+						"product_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Paddle product ID of the price (format: pro_...).",
+						},
+						"quantity": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Quantity of the price.",
+						},
+						"tax_rate": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Tax rate applied, as a decimal string such as `0.2`.",
+						},
+						"unit_totals":           lineTotalsSchema("Totals of one unit."),
+						"totals":                lineTotalsSchema("Totals of the line."),
+						"formatted_unit_totals": formattedTotals("Totals of one unit, formatted for display."),
+						"formatted_totals":      formattedTotals("Totals of the line, formatted for display."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *PricingPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read previews the prices with Paddle API.
+func (d *PricingPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data pricingPreviewDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var items []pricingPreviewItemModel
+	resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the preview request
+	previewReq := &paddle.PreviewPricesRequest{
+		Address: &paddle.AddressPreview{
+			CountryCode: paddle.CountryCode(data.CountryCode.ValueString()),
+		},
+	}
+	for _, item := range items {
+		quantity := 1
+		if !item.Quantity.IsNull() {
+			quantity = int(item.Quantity.ValueInt64())
+		}
+		previewReq.Items = append(previewReq.Items, paddle.PricePreviewItem{
+			PriceID:  item.PriceID.ValueString(),
+			Quantity: quantity,
+		})
+	}
+	if !data.PostalCode.IsNull() {
+		postalCode := data.PostalCode.ValueString()
+		previewReq.Address.PostalCode = &postalCode
+	}
+	if !data.DiscountID.IsNull() {
+		discountID := data.DiscountID.ValueString()
+		previewReq.DiscountID = &discountID
+	}
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		currencyCode := paddle.CurrencyCode(data.CurrencyCode.ValueString())
+		previewReq.CurrencyCode = &currencyCode
+	}
+
+	// Preview prices with Paddle API
+	preview, err := d.client.PreviewPrices(ctx, previewReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading pricing preview",
+			fmt.Sprintf("Could not preview prices: %s", err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	if preview.CurrencyCode != nil {
+		data.CurrencyCode = types.StringValue(string(*preview.CurrencyCode))
+	}
+
+	lineItems := make([]pricingPreviewLineItemModel, len(preview.Details.LineItems))
+	for i, lineItem := range preview.Details.LineItems {
+		model, diags := pricingPreviewLineItemToModel(lineItem)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		lineItems[i] = model
+	}
+
+	lineItemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pricingPreviewLineItemAttrTypes}, lineItems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LineItems = lineItemsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Maps a line item of a pricing preview to its model.
+func pricingPreviewLineItemToModel(lineItem paddle.PricePreviewLineItem) (pricingPreviewLineItemModel, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	model := pricingPreviewLineItemModel{
+		PriceID:   types.StringValue(lineItem.Price.ID),
+		ProductID: types.StringValue(lineItem.Price.ProductID),
+		Quantity:  types.Int64Value(int64(lineItem.Quantity)),
+		TaxRate:   types.StringValue(lineItem.TaxRate),
+	}
+	model.UnitTotals, d = lineTotalsValue(lineItem.UnitTotals)
+	diags.Append(d...)
+	model.Totals, d = lineTotalsValue(lineItem.Totals)
+	diags.Append(d...)
+	model.FormattedUnitTotals, d = formattedTotalsValue(lineItem.FormattedUnitTotals)
+	diags.Append(d...)
+	model.FormattedTotals, d = formattedTotalsValue(lineItem.FormattedTotals)
+	diags.Append(d...)
+
+	return model, diags
+}
+
+// Returns the object value of totals formatted in their currency.
+func formattedTotalsValue(totals paddle.Totals) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(formattedTotalsAttrTypes, map[string]attr.Value{
+		"subtotal": types.StringValue(totals.Subtotal),
+		"discount": types.StringValue(totals.Discount),
+		"tax":      types.StringValue(totals.Tax),
+		"total":    types.StringValue(totals.Total),
+	})
+}
@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPricingPreviewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPreviewDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_pricing_preview.test", "currency_code", "USD"),
+					resource.TestCheckResourceAttr("data.paddle_pricing_preview.test", "line_items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.paddle_pricing_preview.test", "line_items.0.price_id", "paddle_price.test", "id"),
+					resource.TestCheckResourceAttr("data.paddle_pricing_preview.test", "line_items.0.quantity", "2"),
+					resource.TestCheckResourceAttr("data.paddle_pricing_preview.test", "line_items.0.unit_totals.subtotal", "1900"),
+					resource.TestCheckResourceAttr("data.paddle_pricing_preview.test", "line_items.0.totals.subtotal", "3800"),
+					resource.TestCheckResourceAttrSet("data.paddle_pricing_preview.test", "line_items.0.totals.total"),
+					resource.TestCheckResourceAttrSet("data.paddle_pricing_preview.test", "line_items.0.formatted_totals.total"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPricingPreviewDataSourceConfig() string {
+	return `
+resource "paddle_product" "test" {
+  name         = "Test Product for Pricing Preview Data Source"
+  tax_category = "saas"
+}
+
+resource "paddle_price" "test" {
+  product_id  = paddle_product.test.id
+  description = "Test price for pricing preview data source"
+  tax_mode    = "external"
+
+  unit_price = {
+    amount        = "1900"
+    currency_code = "USD"
+  }
+}
+
+data "paddle_pricing_preview" "test" {
+  items = [{
+    price_id = paddle_price.test.id
+    quantity = 2
+  }]
+  country_code  = "US"
+  postal_code   = "10021"
+  currency_code = "USD"
+}
+`
+}
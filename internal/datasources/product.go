@@ -5,7 +5,7 @@ import (
 	"fmt"
 
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
-	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,7 +20,7 @@ func NewProductDataSource() datasource.DataSource {
 
 // A single Paddle product.
 type ProductDataSource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type productDataSourceModel struct {
@@ -93,11 +93,11 @@ func (d *ProductDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -116,9 +116,7 @@ func (d *ProductDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Get product from Paddle API
-	product, err := d.client.GetProduct(ctx, &paddle.GetProductRequest{
-		ProductID: data.ID.ValueString(),
-	})
+	product, err := d.client.ReadProduct(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading product",
@@ -0,0 +1,239 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SimulationRunsDataSource{}
+
+// Number of simulation runs returned when limit is not set.
+const defaultSimulationRunsLimit = 20
+
+// Creates a new simulation runs data source.
+func NewSimulationRunsDataSource() datasource.DataSource {
+	return &SimulationRunsDataSource{}
+}
+
+// The runs of a Paddle simulation, with the events they sent.
+type SimulationRunsDataSource struct {
+	client *paddleclient.Client
+}
+
+type simulationRunsDataSourceModel struct {
+	SimulationID types.String `tfsdk:"simulation_id"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	Runs         types.List   `tfsdk:"runs"`
+}
+
+type simulationRunModel struct {
+	ID        types.String `tfsdk:"id"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Events    types.List   `tfsdk:"events"`
+}
+
+type simulationEventModel struct {
+	ID           types.String `tfsdk:"id"`
+	EventType    types.String `tfsdk:"event_type"`
+	Status       types.String `tfsdk:"status"`
+	ResponseCode types.Int64  `tfsdk:"response_code"`
+	ResponseBody types.String `tfsdk:"response_body"`
+}
+
+var simulationEventAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"event_type":    types.StringType,
+	"status":        types.StringType,
+	"response_code": types.Int64Type,
+	"response_body": types.StringType,
+}
+
+var simulationRunAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"status":     types.StringType,
+	"created_at": types.StringType,
+	"events":     types.ListType{ElemType: types.ObjectType{AttrTypes: simulationEventAttrTypes}},
+}
+
+// Metadata returns the data source type name.
+func (d *SimulationRunsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_simulation_runs"
+}
+
+// Schema returns the data source schema.
+func (d *SimulationRunsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the runs of a Paddle simulation, most recent first, with the delivery result of each event they sent.",
+
+		Attributes: map[string]schema.Attribute{
+			"simulation_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Paddle simulation ID (format: ntfsim_...).",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of runs to return. Defaults to %d.", defaultSimulationRunsLimit),
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Runs of the simulation, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Paddle simulation run ID (format: ntfsimrun_...).",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the run. One of: `pending`, `completed`, `canceled`.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC 3339 timestamp when the run was started.",
+						},
+						"events": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Events sent by the run.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Paddle simulation event ID (format: ntfsimevt_...).",
+									},
+									"event_type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Type of the event sent.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Delivery status of the event. One of: `pending`, `success`, `failed`, `aborted`.",
+									},
+									"response_code": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "HTTP status code the notification setting destination responded with. Null when it did not respond.",
+									},
+									"response_body": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Body the notification setting destination responded with.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *SimulationRunsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the simulation runs from Paddle API.
+func (d *SimulationRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data simulationRunsDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultSimulationRunsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	// List simulation runs, with their events, from Paddle API
+	perPage := 50
+	orderBy := "id[DESC]"
+	listReq := &paddle.ListSimulationRunsRequest{
+		SimulationID:  data.SimulationID.ValueString(),
+		OrderBy:       &orderBy,
+		PerPage:       &perPage,
+		IncludeEvents: true,
+	}
+
+	var runs []*paddle.SimulationRun
+	collection, err := d.client.ListSimulationRuns(ctx, listReq)
+	if err == nil {
+		err = collection.Iter(ctx, func(run *paddle.SimulationRun) (bool, error) {
+			runs = append(runs, run)
+			return int64(len(runs)) < limit, nil
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading simulation runs",
+			fmt.Sprintf("Could not list runs of simulation ID %s: %s", data.SimulationID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	models := make([]simulationRunModel, len(runs))
+	for i, run := range runs {
+		events := make([]simulationEventModel, len(run.Events))
+		for j, event := range run.Events {
+			events[j] = simulationEventModel{
+				ID:           types.StringValue(event.ID),
+				EventType:    types.StringValue(string(event.EventType)),
+				Status:       types.StringValue(string(event.Status)),
+				ResponseCode: types.Int64Null(),
+				ResponseBody: types.StringNull(),
+			}
+			if event.Response != nil {
+				if event.Response.StatusCode != 0 {
+					events[j].ResponseCode = types.Int64Value(int64(event.Response.StatusCode))
+				}
+				events[j].ResponseBody = types.StringValue(event.Response.Body)
+			}
+		}
+
+		eventsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: simulationEventAttrTypes}, events)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		models[i] = simulationRunModel{
+			ID:        types.StringValue(run.ID),
+			Status:    types.StringValue(string(run.Status)),
+			CreatedAt: types.StringValue(run.CreatedAt),
+			Events:    eventsValue,
+		}
+	}
+
+	runsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: simulationRunAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Runs = runsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
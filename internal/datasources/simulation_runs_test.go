@@ -0,0 +1,38 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSimulationRunsDataSource(t *testing.T) {
+	simulationID := os.Getenv("PADDLE_SIMULATION_ID")
+	if simulationID == "" {
+		t.Skip("PADDLE_SIMULATION_ID must be set to read simulation runs")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationRunsDataSourceConfig(simulationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_simulation_runs.test", "simulation_id", simulationID),
+					resource.TestCheckResourceAttrSet("data.paddle_simulation_runs.test", "runs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSimulationRunsDataSourceConfig(simulationID string) string {
+	return `
+data "paddle_simulation_runs" "test" {
+  simulation_id = "` + simulationID + `"
+  limit         = 5
+}
+`
+}
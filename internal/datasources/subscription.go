@@ -0,0 +1,377 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SubscriptionDataSource{}
+
+// Creates a new subscription data source.
+func NewSubscriptionDataSource() datasource.DataSource {
+	return &SubscriptionDataSource{}
+}
+
+// A single Paddle subscription.
+type SubscriptionDataSource struct {
+	client *paddleclient.Client
+}
+
+// Model of a subscription, shared by the subscription and subscriptions data sources.
+type subscriptionModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Status               types.String `tfsdk:"status"`
+	CustomerID           types.String `tfsdk:"customer_id"`
+	AddressID            types.String `tfsdk:"address_id"`
+	BusinessID           types.String `tfsdk:"business_id"`
+	DiscountID           types.String `tfsdk:"discount_id"`
+	CurrencyCode         types.String `tfsdk:"currency_code"`
+	CollectionMode       types.String `tfsdk:"collection_mode"`
+	BillingCycle         types.Object `tfsdk:"billing_cycle"`
+	CurrentBillingPeriod types.Object `tfsdk:"current_billing_period"`
+	NextBilledAt         types.String `tfsdk:"next_billed_at"`
+	ScheduledChange      types.Object `tfsdk:"scheduled_change"`
+	Items                types.List   `tfsdk:"items"`
+	RecurringTotals      types.Object `tfsdk:"recurring_totals"`
+	CustomData           types.Map    `tfsdk:"custom_data"`
+	StartedAt            types.String `tfsdk:"started_at"`
+	FirstBilledAt        types.String `tfsdk:"first_billed_at"`
+	PausedAt             types.String `tfsdk:"paused_at"`
+	CanceledAt           types.String `tfsdk:"canceled_at"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+type subscriptionItemModel struct {
+	PriceID      types.String `tfsdk:"price_id"`
+	ProductID    types.String `tfsdk:"product_id"`
+	Status       types.String `tfsdk:"status"`
+	Quantity     types.Int64  `tfsdk:"quantity"`
+	Recurring    types.Bool   `tfsdk:"recurring"`
+	UnitPrice    types.Object `tfsdk:"unit_price"`
+	BillingCycle types.Object `tfsdk:"billing_cycle"`
+	NextBilledAt types.String `tfsdk:"next_billed_at"`
+}
+
+var subscriptionScheduledChangeAttrTypes = map[string]attr.Type{
+	"action":       types.StringType,
+	"effective_at": types.StringType,
+	"resume_at":    types.StringType,
+}
+
+var subscriptionItemAttrTypes = map[string]attr.Type{
+	"price_id":       types.StringType,
+	"product_id":     types.StringType,
+	"status":         types.StringType,
+	"quantity":       types.Int64Type,
+	"recurring":      types.BoolType,
+	"unit_price":     types.ObjectType{AttrTypes: moneyAttrTypes},
+	"billing_cycle":  types.ObjectType{AttrTypes: durationAttrTypes},
+	"next_billed_at": types.StringType,
+}
+
+var subscriptionAttrTypes = map[string]attr.Type{
+	"id":                     types.StringType,
+	"status":                 types.StringType,
+	"customer_id":            types.StringType,
+	"address_id":             types.StringType,
+	"business_id":            types.StringType,
+	"discount_id":            types.StringType,
+	"currency_code":          types.StringType,
+	"collection_mode":        types.StringType,
+	"billing_cycle":          types.ObjectType{AttrTypes: durationAttrTypes},
+	"current_billing_period": types.ObjectType{AttrTypes: timePeriodAttrTypes},
+	"next_billed_at":         types.StringType,
+	"scheduled_change":       types.ObjectType{AttrTypes: subscriptionScheduledChangeAttrTypes},
+	"items":                  types.ListType{ElemType: types.ObjectType{AttrTypes: subscriptionItemAttrTypes}},
+	"recurring_totals":       types.ObjectType{AttrTypes: totalsAttrTypes},
+	"custom_data":            types.MapType{ElemType: types.StringType},
+	"started_at":             types.StringType,
+	"first_billed_at":        types.StringType,
+	"paused_at":              types.StringType,
+	"canceled_at":            types.StringType,
+	"created_at":             types.StringType,
+	"updated_at":             types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *SubscriptionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+// Schema returns the data source schema.
+func (d *SubscriptionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := subscriptionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Paddle subscription ID (format: sub_...).",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves details of a specific Paddle subscription by ID.",
+		Attributes:          attributes,
+	}
+}
+
+// Returns the computed attributes of a subscription.
+func subscriptionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle subscription ID (format: sub_...).",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Status of the subscription: `active`, `canceled`, `past_due`, `paused` or `trialing`.",
+		},
+		"customer_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the customer this subscription is for.",
+		},
+		"address_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the address this subscription is billed to.",
+		},
+		"business_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the business this subscription is billed to. Null when there is none.",
+		},
+		"discount_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the discount applied to this subscription. Null when there is none.",
+		},
+		"currency_code": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Three-letter ISO 4217 currency code the subscription is billed in.",
+		},
+		"collection_mode": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How payment is collected: `automatic` for checkout, or `manual` for invoices.",
+		},
+		"billing_cycle":          durationSchema("How often the subscription renews."),
+		"current_billing_period": timePeriodSchema("Current billing period. Null when the subscription is not active or trialing."),
+		"next_billed_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription is next billed. Null when it does not renew.",
+		},
+		"scheduled_change": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Change scheduled for the subscription. Null when there is none.",
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Scheduled change: `cancel`, `pause` or `resume`.",
+				},
+				"effective_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "RFC 3339 timestamp when the change takes effect.",
+				},
+				"resume_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "RFC 3339 timestamp when a paused subscription resumes. Null when it does not resume automatically.",
+				},
+			},
+		},
+		"items": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Prices the subscription bills for.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"price_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Paddle price ID (format: pri_...).",
+					},
+					"product_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Paddle product ID of the price (format: pro_...).",
+					},
+					"status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Status of the item: `active`, `inactive` or `trialing`.",
+					},
+					"quantity": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Quantity of the price billed.",
+					},
+					"recurring": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the item is billed on every renewal, rather than once.",
+					},
+					"unit_price":    moneySchema("Unit price of the item."),
+					"billing_cycle": durationSchema("How often the price is billed. Null for one-time prices."),
+					"next_billed_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "RFC 3339 timestamp when the item is next billed.",
+					},
+				},
+			},
+		},
+		"recurring_totals": totalsSchema("Totals of the transaction created on each renewal."),
+		"custom_data": schema.MapAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Custom data for this subscription.",
+		},
+		"started_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription started.",
+		},
+		"first_billed_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription was first billed.",
+		},
+		"paused_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription was paused. Null when it is not paused.",
+		},
+		"canceled_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription was canceled. Null when it is not canceled.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the subscription was last updated.",
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *SubscriptionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the subscription from Paddle API.
+func (d *SubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subscriptionModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := d.client.GetSubscription(ctx, &paddle.GetSubscriptionRequest{
+		SubscriptionID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subscription",
+			fmt.Sprintf("Could not read subscription ID %s: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	data, diags := subscriptionToModel(ctx, subscription)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Maps a Paddle subscription to its model.
+func subscriptionToModel(ctx context.Context, subscription *paddle.Subscription) (subscriptionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	data := subscriptionModel{
+		ID:             types.StringValue(subscription.ID),
+		Status:         types.StringValue(string(subscription.Status)),
+		CustomerID:     types.StringValue(subscription.CustomerID),
+		AddressID:      types.StringValue(subscription.AddressID),
+		BusinessID:     types.StringPointerValue(subscription.BusinessID),
+		DiscountID:     types.StringNull(),
+		CurrencyCode:   types.StringValue(string(subscription.CurrencyCode)),
+		CollectionMode: types.StringValue(string(subscription.CollectionMode)),
+		NextBilledAt:   types.StringPointerValue(subscription.NextBilledAt),
+		StartedAt:      types.StringPointerValue(subscription.StartedAt),
+		FirstBilledAt:  types.StringPointerValue(subscription.FirstBilledAt),
+		PausedAt:       types.StringPointerValue(subscription.PausedAt),
+		CanceledAt:     types.StringPointerValue(subscription.CanceledAt),
+		CreatedAt:      types.StringValue(subscription.CreatedAt),
+		UpdatedAt:      types.StringValue(subscription.UpdatedAt),
+	}
+	if subscription.Discount != nil {
+		data.DiscountID = types.StringValue(subscription.Discount.ID)
+	}
+
+	data.BillingCycle, d = durationValue(&subscription.BillingCycle)
+	diags.Append(d...)
+	data.CurrentBillingPeriod, d = timePeriodValue(subscription.CurrentBillingPeriod)
+	diags.Append(d...)
+	data.RecurringTotals, d = totalsValue(subscription.RecurringTransactionDetails.Totals)
+	diags.Append(d...)
+
+	data.ScheduledChange = types.ObjectNull(subscriptionScheduledChangeAttrTypes)
+	if change := subscription.ScheduledChange; change != nil {
+		data.ScheduledChange, d = types.ObjectValue(subscriptionScheduledChangeAttrTypes, map[string]attr.Value{
+			"action":       types.StringValue(string(change.Action)),
+			"effective_at": types.StringValue(change.EffectiveAt),
+			"resume_at":    types.StringPointerValue(change.ResumeAt),
+		})
+		diags.Append(d...)
+	}
+
+	items := make([]subscriptionItemModel, len(subscription.Items))
+	for i, item := range subscription.Items {
+		items[i] = subscriptionItemModel{
+			PriceID:      types.StringValue(item.Price.ID),
+			ProductID:    types.StringValue(item.Price.ProductID),
+			Status:       types.StringValue(string(item.Status)),
+			Quantity:     types.Int64Value(int64(item.Quantity)),
+			Recurring:    types.BoolValue(item.Recurring),
+			NextBilledAt: types.StringPointerValue(item.NextBilledAt),
+		}
+		items[i].UnitPrice, d = moneyValue(item.Price.UnitPrice)
+		diags.Append(d...)
+		items[i].BillingCycle, d = durationValue(item.Price.BillingCycle)
+		diags.Append(d...)
+	}
+	data.Items, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: subscriptionItemAttrTypes}, items)
+	diags.Append(d...)
+
+	data.CustomData = types.MapNull(types.StringType)
+	if subscription.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(subscription.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for subscription %s: %s", subscription.ID, err.Error()),
+			)
+			return data, diags
+		}
+		data.CustomData, d = types.MapValue(types.StringType, customDataMap)
+		diags.Append(d...)
+	}
+
+	return data, diags
+}
@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionDataSource(t *testing.T) {
+	subscriptionID := os.Getenv("PADDLE_SUBSCRIPTION_ID")
+	if subscriptionID == "" {
+		t.Skip("PADDLE_SUBSCRIPTION_ID must be set to read a subscription")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionDataSourceConfig(subscriptionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_subscription.test", "id", subscriptionID),
+					resource.TestCheckResourceAttrSet("data.paddle_subscription.test", "status"),
+					resource.TestCheckResourceAttrSet("data.paddle_subscription.test", "customer_id"),
+					resource.TestCheckResourceAttrSet("data.paddle_subscription.test", "collection_mode"),
+					resource.TestCheckResourceAttrSet("data.paddle_subscription.test", "items.0.price_id"),
+					resource.TestCheckResourceAttrSet("data.paddle_subscription.test", "items.0.unit_price.amount"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscriptionDataSourceConfig(subscriptionID string) string {
+	return `
+data "paddle_subscription" "test" {
+  id = "` + subscriptionID + `"
+}
+`
+}
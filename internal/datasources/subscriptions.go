@@ -0,0 +1,202 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SubscriptionsDataSource{}
+
+// Number of subscriptions returned when limit is not set.
+const defaultSubscriptionsLimit = 100
+
+// Creates a new subscriptions data source.
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+// The Paddle subscriptions matching filters.
+type SubscriptionsDataSource struct {
+	client *paddleclient.Client
+}
+
+type subscriptionsDataSourceModel struct {
+	CustomerID    types.String        `tfsdk:"customer_id"`
+	PriceID       types.String        `tfsdk:"price_id"`
+	Status        types.List          `tfsdk:"status"`
+	From          customtypes.RFC3339 `tfsdk:"from"`
+	To            customtypes.RFC3339 `tfsdk:"to"`
+	Limit         types.Int64         `tfsdk:"limit"`
+	IDs           types.List          `tfsdk:"ids"`
+	Subscriptions types.List          `tfsdk:"subscriptions"`
+}
+
+// Metadata returns the data source type name.
+func (d *SubscriptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+// Schema returns the data source schema.
+func (d *SubscriptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Paddle subscriptions matching filters, most recent first.",
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only subscriptions of this customer (format: ctm_...).",
+			},
+			"price_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only subscriptions with an item for this price (format: pri_...).",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only subscriptions with these statuses: `active`, `canceled`, `past_due`, `paused` or `trialing`.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only subscriptions created at or after this RFC 3339 timestamp.",
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only subscriptions created before this RFC 3339 timestamp.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of subscriptions to return. Defaults to %d.", defaultSubscriptionsLimit),
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the subscriptions.",
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subscriptions matching the filters, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subscriptionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *SubscriptionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read lists the subscriptions from Paddle API.
+func (d *SubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subscriptionsDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []string
+	if !data.Status.IsNull() {
+		resp.Diagnostics.Append(data.Status.ElementsAs(ctx, &statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	created, err := newCreatedRange(data.From, data.To)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Time Range", err.Error())
+		return
+	}
+
+	limit := int64(defaultSubscriptionsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	// List subscriptions from Paddle API. IDs sort by creation time, so
+	// listing stops at the first subscription created before from.
+	perPage := 200
+	orderBy := "id[DESC]"
+	listReq := &paddle.ListSubscriptionsRequest{
+		Status:  statuses,
+		OrderBy: &orderBy,
+		PerPage: &perPage,
+	}
+	if !data.CustomerID.IsNull() {
+		listReq.CustomerID = []string{data.CustomerID.ValueString()}
+	}
+	if !data.PriceID.IsNull() {
+		listReq.PriceID = []string{data.PriceID.ValueString()}
+	}
+
+	var subscriptions []*paddle.Subscription
+	collection, err := d.client.ListSubscriptions(ctx, listReq)
+	if err == nil && limit > 0 {
+		err = collection.Iter(ctx, func(subscription *paddle.Subscription) (bool, error) {
+			in, more := created.check(subscription.CreatedAt)
+			if in {
+				subscriptions = append(subscriptions, subscription)
+			}
+			return more && int64(len(subscriptions)) < limit, nil
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subscriptions",
+			fmt.Sprintf("Could not list subscriptions: %s", err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	ids := make([]string, len(subscriptions))
+	models := make([]subscriptionModel, len(subscriptions))
+	for i, subscription := range subscriptions {
+		model, diags := subscriptionToModel(ctx, subscription)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ids[i] = subscription.ID
+		models[i] = model
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	subscriptionsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: subscriptionAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsValue
+	data.Subscriptions = subscriptionsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
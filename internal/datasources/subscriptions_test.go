@@ -0,0 +1,31 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.paddle_subscriptions.test", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.paddle_subscriptions.test", "subscriptions.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSubscriptionsDataSourceConfig = `
+data "paddle_subscriptions" "test" {
+  status = ["active", "trialing"]
+  from   = "2024-01-01T00:00:00Z"
+  limit  = 5
+}
+`
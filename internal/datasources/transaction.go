@@ -0,0 +1,319 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TransactionDataSource{}
+
+// Creates a new transaction data source.
+func NewTransactionDataSource() datasource.DataSource {
+	return &TransactionDataSource{}
+}
+
+// A single Paddle transaction.
+type TransactionDataSource struct {
+	client *paddleclient.Client
+}
+
+// Model of a transaction, shared by the transaction and transactions data sources.
+type transactionModel struct {
+	ID             types.String `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+	Origin         types.String `tfsdk:"origin"`
+	CustomerID     types.String `tfsdk:"customer_id"`
+	AddressID      types.String `tfsdk:"address_id"`
+	BusinessID     types.String `tfsdk:"business_id"`
+	DiscountID     types.String `tfsdk:"discount_id"`
+	SubscriptionID types.String `tfsdk:"subscription_id"`
+	InvoiceNumber  types.String `tfsdk:"invoice_number"`
+	CurrencyCode   types.String `tfsdk:"currency_code"`
+	CollectionMode types.String `tfsdk:"collection_mode"`
+	BillingPeriod  types.Object `tfsdk:"billing_period"`
+	Items          types.List   `tfsdk:"items"`
+	Totals         types.Object `tfsdk:"totals"`
+	CustomData     types.Map    `tfsdk:"custom_data"`
+	BilledAt       types.String `tfsdk:"billed_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+type transactionItemModel struct {
+	PriceID      types.String `tfsdk:"price_id"`
+	ProductID    types.String `tfsdk:"product_id"`
+	Quantity     types.Int64  `tfsdk:"quantity"`
+	UnitPrice    types.Object `tfsdk:"unit_price"`
+	BillingCycle types.Object `tfsdk:"billing_cycle"`
+	Totals       types.Object `tfsdk:"totals"`
+}
+
+// Attribute types of a transaction line item.
+var transactionItemAttrTypes = map[string]attr.Type{
+	"price_id":      types.StringType,
+	"product_id":    types.StringType,
+	"quantity":      types.Int64Type,
+	"unit_price":    types.ObjectType{AttrTypes: moneyAttrTypes},
+	"billing_cycle": types.ObjectType{AttrTypes: durationAttrTypes},
+	"totals":        types.ObjectType{AttrTypes: lineTotalsAttrTypes},
+}
+
+var transactionAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"status":          types.StringType,
+	"origin":          types.StringType,
+	"customer_id":     types.StringType,
+	"address_id":      types.StringType,
+	"business_id":     types.StringType,
+	"discount_id":     types.StringType,
+	"subscription_id": types.StringType,
+	"invoice_number":  types.StringType,
+	"currency_code":   types.StringType,
+	"collection_mode": types.StringType,
+	"billing_period":  types.ObjectType{AttrTypes: timePeriodAttrTypes},
+	"items":           types.ListType{ElemType: types.ObjectType{AttrTypes: transactionItemAttrTypes}},
+	"totals":          types.ObjectType{AttrTypes: totalsAttrTypes},
+	"custom_data":     types.MapType{ElemType: types.StringType},
+	"billed_at":       types.StringType,
+	"created_at":      types.StringType,
+	"updated_at":      types.StringType,
+}
+
+// Metadata returns the data source type name.
+func (d *TransactionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction"
+}
+
+// Schema returns the data source schema.
+func (d *TransactionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := transactionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Paddle transaction ID (format: txn_...).",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves details of a specific Paddle transaction by ID.",
+		Attributes:          attributes,
+	}
+}
+
+// Returns the computed attributes of a transaction.
+func transactionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle transaction ID (format: txn_...).",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Status of the transaction: `draft`, `ready`, `billed`, `paid`, `completed`, `canceled` or `past_due`.",
+		},
+		"origin": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How the transaction was created, such as `web`, `api` or `subscription_recurring`.",
+		},
+		"customer_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the customer this transaction is for. Null when there is none yet.",
+		},
+		"address_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the address this transaction is billed to. Null when there is none yet.",
+		},
+		"business_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the business this transaction is billed to. Null when there is none.",
+		},
+		"discount_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the discount applied to this transaction. Null when there is none.",
+		},
+		"subscription_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Paddle ID of the subscription this transaction is for. Null when it is not for a subscription.",
+		},
+		"invoice_number": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Invoice number of the transaction, once it is billed or completed.",
+		},
+		"currency_code": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Three-letter ISO 4217 currency code the transaction is billed in.",
+		},
+		"collection_mode": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How payment is collected: `automatic` for checkout, or `manual` for invoices.",
+		},
+		"billing_period": timePeriodSchema("Period the transaction bills for. Null when it is not for a subscription."),
+		"items": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Prices the transaction bills for.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"price_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Paddle price ID (format: pri_...).",
+					},
+					"product_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Paddle product ID of the price (format: pro_...).",
+					},
+					"quantity": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Quantity of the price billed.",
+					},
+					"unit_price":    moneySchema("Unit price of the item."),
+					"billing_cycle": durationSchema("How often the price is billed. Null for one-time prices."),
+					"totals":        lineTotalsSchema("Totals of the line."),
+				},
+			},
+		},
+		"totals": totalsSchema("Totals of the transaction."),
+		"custom_data": schema.MapAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Custom data for this transaction.",
+		},
+		"billed_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the transaction was billed. Null when it is not billed yet.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the transaction was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "RFC 3339 timestamp when the transaction was last updated.",
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *TransactionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the transaction from Paddle API.
+func (d *TransactionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data transactionModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transaction, err := d.client.GetTransaction(ctx, &paddle.GetTransactionRequest{
+		TransactionID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading transaction",
+			fmt.Sprintf("Could not read transaction ID %s: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	data, diags := transactionToModel(ctx, transaction)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Maps a Paddle transaction to its model.
+func transactionToModel(ctx context.Context, transaction *paddle.Transaction) (transactionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	data := transactionModel{
+		ID:             types.StringValue(transaction.ID),
+		Status:         types.StringValue(string(transaction.Status)),
+		Origin:         types.StringValue(string(transaction.Origin)),
+		CustomerID:     types.StringPointerValue(transaction.CustomerID),
+		AddressID:      types.StringPointerValue(transaction.AddressID),
+		BusinessID:     types.StringPointerValue(transaction.BusinessID),
+		DiscountID:     types.StringPointerValue(transaction.DiscountID),
+		SubscriptionID: types.StringPointerValue(transaction.SubscriptionID),
+		InvoiceNumber:  types.StringPointerValue(transaction.InvoiceNumber),
+		CurrencyCode:   types.StringValue(string(transaction.CurrencyCode)),
+		CollectionMode: types.StringValue(string(transaction.CollectionMode)),
+		BilledAt:       types.StringPointerValue(transaction.BilledAt),
+		CreatedAt:      types.StringValue(transaction.CreatedAt),
+		UpdatedAt:      types.StringValue(transaction.UpdatedAt),
+	}
+
+	data.BillingPeriod, d = timePeriodValue(transaction.BillingPeriod)
+	diags.Append(d...)
+	data.Totals, d = totalsValue(transaction.Details.Totals)
+	diags.Append(d...)
+
+	// Line totals are in details, in the same order as items
+	items := make([]transactionItemModel, len(transaction.Items))
+	for i, item := range transaction.Items {
+		items[i] = transactionItemModel{
+			PriceID:   types.StringValue(item.Price.ID),
+			ProductID: types.StringValue(item.Price.ProductID),
+			Quantity:  types.Int64Value(int64(item.Quantity)),
+			Totals:    types.ObjectNull(lineTotalsAttrTypes),
+		}
+		items[i].UnitPrice, d = moneyValue(item.Price.UnitPrice)
+		diags.Append(d...)
+		items[i].BillingCycle, d = durationValue(item.Price.BillingCycle)
+		diags.Append(d...)
+
+		for _, line := range transaction.Details.LineItems {
+			if line.PriceID == item.Price.ID {
+				items[i].Totals, d = lineTotalsValue(line.Totals)
+				diags.Append(d...)
+				break
+			}
+		}
+	}
+	data.Items, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: transactionItemAttrTypes}, items)
+	diags.Append(d...)
+
+	data.CustomData = types.MapNull(types.StringType)
+	if transaction.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(transaction.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for transaction %s: %s", transaction.ID, err.Error()),
+			)
+			return data, diags
+		}
+		data.CustomData, d = types.MapValue(types.StringType, customDataMap)
+		diags.Append(d...)
+	}
+
+	return data, diags
+}
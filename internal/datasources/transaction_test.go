@@ -0,0 +1,40 @@
+package datasources_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransactionDataSource(t *testing.T) {
+	transactionID := os.Getenv("PADDLE_TRANSACTION_ID")
+	if transactionID == "" {
+		t.Skip("PADDLE_TRANSACTION_ID must be set to read a transaction")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionDataSourceConfig(transactionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paddle_transaction.test", "id", transactionID),
+					resource.TestCheckResourceAttrSet("data.paddle_transaction.test", "status"),
+					resource.TestCheckResourceAttrSet("data.paddle_transaction.test", "collection_mode"),
+					resource.TestCheckResourceAttrSet("data.paddle_transaction.test", "totals.total"),
+					resource.TestCheckResourceAttrSet("data.paddle_transaction.test", "items.0.price_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransactionDataSourceConfig(transactionID string) string {
+	return `
+data "paddle_transaction" "test" {
+  id = "` + transactionID + `"
+}
+`
+}
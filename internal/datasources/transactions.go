@@ -0,0 +1,218 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TransactionsDataSource{}
+
+// Number of transactions returned when limit is not set.
+const defaultTransactionsLimit = 100
+
+// Creates a new transactions data source.
+func NewTransactionsDataSource() datasource.DataSource {
+	return &TransactionsDataSource{}
+}
+
+// The Paddle transactions matching filters.
+type TransactionsDataSource struct {
+	client *paddleclient.Client
+}
+
+type transactionsDataSourceModel struct {
+	CustomerID     types.String        `tfsdk:"customer_id"`
+	PriceID        types.String        `tfsdk:"price_id"`
+	SubscriptionID types.String        `tfsdk:"subscription_id"`
+	Status         types.List          `tfsdk:"status"`
+	From           customtypes.RFC3339 `tfsdk:"from"`
+	To             customtypes.RFC3339 `tfsdk:"to"`
+	Limit          types.Int64         `tfsdk:"limit"`
+	IDs            types.List          `tfsdk:"ids"`
+	Transactions   types.List          `tfsdk:"transactions"`
+}
+
+// Metadata returns the data source type name.
+func (d *TransactionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transactions"
+}
+
+// Schema returns the data source schema.
+func (d *TransactionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Paddle transactions matching filters, most recent first.",
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only transactions of this customer (format: ctm_...).",
+			},
+			"price_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only transactions with an item for this price (format: pri_...).",
+			},
+			"subscription_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only transactions of this subscription (format: sub_...).",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only transactions with these statuses: `draft`, `ready`, `billed`, `paid`, `completed`, `canceled` or `past_due`.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only transactions created at or after this RFC 3339 timestamp.",
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
+				MarkdownDescription: "Return only transactions created before this RFC 3339 timestamp.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of transactions to return. Defaults to %d.", defaultTransactionsLimit),
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the transactions.",
+			},
+			"transactions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Transactions matching the filters, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: transactionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes the data source with the Paddle SDK client.
+func (d *TransactionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read lists the transactions from Paddle API.
+func (d *TransactionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data transactionsDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []string
+	if !data.Status.IsNull() {
+		resp.Diagnostics.Append(data.Status.ElementsAs(ctx, &statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	created, err := newCreatedRange(data.From, data.To)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Time Range", err.Error())
+		return
+	}
+
+	limit := int64(defaultTransactionsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	// List transactions from Paddle API, most recently created first, so that
+	// listing stops at the first transaction created before from. Prices are
+	// filtered here, as the Paddle API cannot filter transactions by price.
+	perPage := 30
+	orderBy := "created_at[DESC]"
+	listReq := &paddle.ListTransactionsRequest{
+		Status:  statuses,
+		OrderBy: &orderBy,
+		PerPage: &perPage,
+	}
+	if !data.CustomerID.IsNull() {
+		listReq.CustomerID = []string{data.CustomerID.ValueString()}
+	}
+	if !data.SubscriptionID.IsNull() {
+		listReq.SubscriptionID = []string{data.SubscriptionID.ValueString()}
+	}
+
+	var transactions []*paddle.Transaction
+	collection, err := d.client.ListTransactions(ctx, listReq)
+	if err == nil && limit > 0 {
+		err = collection.Iter(ctx, func(transaction *paddle.Transaction) (bool, error) {
+			in, more := created.check(transaction.CreatedAt)
+			if in && (data.PriceID.IsNull() || transactionHasPrice(transaction, data.PriceID.ValueString())) {
+				transactions = append(transactions, transaction)
+			}
+			return more && int64(len(transactions)) < limit, nil
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading transactions",
+			fmt.Sprintf("Could not list transactions: %s", err.Error()),
+		)
+		return
+	}
+
+	// Map response to model
+	ids := make([]string, len(transactions))
+	models := make([]transactionModel, len(transactions))
+	for i, transaction := range transactions {
+		model, diags := transactionToModel(ctx, transaction)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ids[i] = transaction.ID
+		models[i] = model
+	}
+
+	idsValue, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	transactionsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: transactionAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsValue
+	data.Transactions = transactionsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Returns whether a transaction has an item for a price.
+func transactionHasPrice(transaction *paddle.Transaction, priceID string) bool {
+	for _, item := range transaction.Items {
+		if item.Price.ID == priceID {
+			return true
+		}
+	}
+	return false
+}
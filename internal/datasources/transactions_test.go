@@ -0,0 +1,31 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransactionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.paddle_transactions.test", "ids.#"),
+					resource.TestCheckResourceAttrSet("data.paddle_transactions.test", "transactions.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTransactionsDataSourceConfig = `
+data "paddle_transactions" "test" {
+  status = ["completed"]
+  from   = "2024-01-01T00:00:00Z"
+  limit  = 5
+}
+`
@@ -0,0 +1,113 @@
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &CustomerAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CustomerAuthTokenEphemeralResource{}
+
+// Creates a new customer authentication token ephemeral resource.
+func NewCustomerAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &CustomerAuthTokenEphemeralResource{}
+}
+
+// A Paddle customer authentication token, used by Paddle.js to show the saved
+// payment methods of a customer at checkout.
+type CustomerAuthTokenEphemeralResource struct {
+	client *paddleclient.Client
+}
+
+type customerAuthTokenModel struct {
+	CustomerID types.String `tfsdk:"customer_id"`
+	Token      types.String `tfsdk:"token"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *CustomerAuthTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_auth_token"
+}
+
+// Schema returns the ephemeral resource schema.
+func (e *CustomerAuthTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a Paddle customer authentication token, which Paddle.js uses to show the saved payment methods of a customer at checkout. " +
+			"The token authenticates as the customer, so it is never stored in plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Paddle ID of the customer to generate the token for (format: ctm_...), for example from `paddle_customer`.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Customer authentication token to pass as `customerAuthToken` when opening a Paddle.js checkout.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the token expires.",
+			},
+		},
+	}
+}
+
+// Configure initializes the ephemeral resource with the Paddle SDK client.
+func (e *CustomerAuthTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open generates a customer authentication token with Paddle API.
+func (e *CustomerAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data customerAuthTokenModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate token via Paddle API
+	token, err := e.client.GenerateCustomerAuthenticationToken(ctx, &paddle.GenerateCustomerAuthenticationTokenRequest{
+		CustomerID: data.CustomerID.ValueString(),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Config.Schema.Type(),
+			"Error generating customer authentication token",
+			fmt.Sprintf("Could not generate an authentication token for customer ID %s", data.CustomerID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Map response to model
+	data.Token = types.StringValue(token.CustomerAuthToken)
+	data.ExpiresAt = types.StringValue(token.ExpiresAt)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
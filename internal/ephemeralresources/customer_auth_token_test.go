@@ -0,0 +1,40 @@
+package ephemeralresources_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/ephemeralresources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCustomerAuthTokenEphemeralResource_Open(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	e := ephemeralresources.NewCustomerAuthTokenEphemeralResource()
+	testConfigure(t, e, `{"data":{"customer_auth_token":"pca_01","expires_at":"2024-01-01T00:30:00Z"},"meta":{"request_id":"req_01"}}`, &requests)
+
+	resp := testOpen(t, e, map[string]tftypes.Value{
+		"customer_id": tftypes.NewValue(tftypes.String, "ctm_01"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "POST /customers/ctm_01/auth-token ") {
+		t.Errorf("expected an auth token request, got %v", requests)
+	}
+
+	var token, expiresAt types.String
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("token"), &token)...)
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if token.ValueString() != "pca_01" || expiresAt.ValueString() != "2024-01-01T00:30:00Z" {
+		t.Errorf("unexpected token %s expiring at %s", token, expiresAt)
+	}
+}
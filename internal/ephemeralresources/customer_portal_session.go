@@ -0,0 +1,185 @@
+// Package ephemeralresources implements the Paddle ephemeral resources, which
+// return short-lived credentials that Terraform never stores in plan or state.
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &CustomerPortalSessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CustomerPortalSessionEphemeralResource{}
+
+// Creates a new customer portal session ephemeral resource.
+func NewCustomerPortalSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &CustomerPortalSessionEphemeralResource{}
+}
+
+// An authenticated Paddle customer portal session, opened for a customer.
+type CustomerPortalSessionEphemeralResource struct {
+	client *paddleclient.Client
+}
+
+type customerPortalSessionModel struct {
+	CustomerID      types.String `tfsdk:"customer_id"`
+	SubscriptionIDs types.List   `tfsdk:"subscription_ids"`
+	ID              types.String `tfsdk:"id"`
+	OverviewURL     types.String `tfsdk:"overview_url"`
+	Subscriptions   types.List   `tfsdk:"subscriptions"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+type portalSubscriptionModel struct {
+	ID                                 types.String `tfsdk:"id"`
+	CancelSubscriptionURL              types.String `tfsdk:"cancel_subscription_url"`
+	UpdateSubscriptionPaymentMethodURL types.String `tfsdk:"update_subscription_payment_method_url"`
+}
+
+var portalSubscriptionAttrTypes = map[string]attr.Type{
+	"id":                                     types.StringType,
+	"cancel_subscription_url":                types.StringType,
+	"update_subscription_payment_method_url": types.StringType,
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *CustomerPortalSessionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_portal_session"
+}
+
+// Schema returns the ephemeral resource schema.
+func (e *CustomerPortalSessionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Opens an authenticated Paddle customer portal session for a customer. " +
+			"The links sign the customer in without a one-time password, so they are never stored in plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Paddle ID of the customer to open the session for (format: ctm_...), for example from `paddle_customer`.",
+			},
+			"subscription_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paddle IDs of subscriptions of the customer to return deep links for (format: sub_...).",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle customer portal session ID (format: cpls_...).",
+			},
+			"overview_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Authenticated link to the overview page of the customer portal.",
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authenticated deep links for each subscription in `subscription_ids`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Paddle subscription ID (format: sub_...).",
+						},
+						"cancel_subscription_url": schema.StringAttribute{
+							Computed:            true,
+							Sensitive:           true,
+							MarkdownDescription: "Authenticated link to the cancellation flow of the subscription.",
+						},
+						"update_subscription_payment_method_url": schema.StringAttribute{
+							Computed:            true,
+							Sensitive:           true,
+							MarkdownDescription: "Authenticated link to the payment method update form of the subscription.",
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the session was opened.",
+			},
+		},
+	}
+}
+
+// Configure initializes the ephemeral resource with the Paddle SDK client.
+func (e *CustomerPortalSessionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open creates a customer portal session with Paddle API.
+func (e *CustomerPortalSessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data customerPortalSessionModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &paddle.CreateCustomerPortalSessionRequest{
+		CustomerID: data.CustomerID.ValueString(),
+	}
+	if !data.SubscriptionIDs.IsNull() {
+		resp.Diagnostics.Append(data.SubscriptionIDs.ElementsAs(ctx, &createReq.SubscriptionIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create customer portal session via Paddle API
+	session, err := e.client.CreateCustomerPortalSession(ctx, createReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Config.Schema.Type(),
+			"Error opening customer portal session",
+			fmt.Sprintf("Could not open a customer portal session for customer ID %s", data.CustomerID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Map response to model
+	data.ID = types.StringValue(session.ID)
+	data.OverviewURL = types.StringValue(session.URLs.General.Overview)
+	data.CreatedAt = types.StringValue(session.CreatedAt)
+
+	subscriptions := make([]portalSubscriptionModel, len(session.URLs.Subscriptions))
+	for i, subscription := range session.URLs.Subscriptions {
+		subscriptions[i] = portalSubscriptionModel{
+			ID:                                 types.StringValue(subscription.ID),
+			CancelSubscriptionURL:              types.StringValue(subscription.CancelSubscription),
+			UpdateSubscriptionPaymentMethodURL: types.StringValue(subscription.UpdateSubscriptionPaymentMethod),
+		}
+	}
+	subscriptionsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: portalSubscriptionAttrTypes}, subscriptions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Subscriptions = subscriptionsValue
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package ephemeralresources_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/ephemeralresources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCustomerPortalSessionEphemeralResource_Open(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	e := ephemeralresources.NewCustomerPortalSessionEphemeralResource()
+	testConfigure(t, e, `{"data":{"id":"cpls_01","customer_id":"ctm_01","urls":{"general":{"overview":"https://customer-portal.paddle.com/cpl_01?action=overview&token=pga_01"},"subscriptions":[{"id":"sub_01","cancel_subscription":"https://customer-portal.paddle.com/cpl_01?action=cancel_subscription&token=pga_01","update_subscription_payment_method":"https://customer-portal.paddle.com/cpl_01?action=update_subscription_payment_method&token=pga_01"}]},"created_at":"2024-01-01T00:00:00Z"},"meta":{"request_id":"req_01"}}`, &requests)
+
+	resp := testOpen(t, e, map[string]tftypes.Value{
+		"customer_id":      tftypes.NewValue(tftypes.String, "ctm_01"),
+		"subscription_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "sub_01")}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "POST /customers/ctm_01/portal-sessions ") || !strings.Contains(requests[0], `"subscription_ids":["sub_01"]`) {
+		t.Errorf("expected a portal session request for the subscription, got %v", requests)
+	}
+
+	var overviewURL, cancelURL types.String
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("overview_url"), &overviewURL)...)
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("subscriptions").AtListIndex(0).AtName("cancel_subscription_url"), &cancelURL)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !strings.Contains(overviewURL.ValueString(), "action=overview") {
+		t.Errorf("unexpected overview_url %s", overviewURL)
+	}
+	if !strings.Contains(cancelURL.ValueString(), "action=cancel_subscription") {
+		t.Errorf("unexpected cancel_subscription_url %s", cancelURL)
+	}
+}
@@ -0,0 +1,78 @@
+package ephemeralresources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Returns a client for environment calling a fake Paddle API served by handler.
+func testPaddleClient(t *testing.T, environment string, handler http.Handler) *paddleclient.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sdk, err := paddle.New("test", paddle.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return paddleclient.New(sdk, environment)
+}
+
+// Returns a handler answering every request with body, and recording each
+// request as its method, path and body.
+func testRecordingHandler(requests *[]string, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestBody, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, r.Method+" "+r.URL.Path+" "+string(requestBody))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// Configures an ephemeral resource with a fake Paddle API that answers every
+// request with body, and records the requests it receives.
+func testConfigure(t *testing.T, e ephemeral.EphemeralResource, body string, requests *[]string) {
+	t.Helper()
+
+	var configureResp ephemeral.ConfigureResponse
+	e.(ephemeral.EphemeralResourceWithConfigure).Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: testPaddleClient(t, "sandbox", testRecordingHandler(requests, body))}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+}
+
+// Opens an ephemeral resource with a configuration whose attributes are null
+// except the given ones.
+func testOpen(t *testing.T, e ephemeral.EphemeralResource, attributes map[string]tftypes.Value) ephemeral.OpenResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	raw := tftypes.NewValue(objectType, values)
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: raw.Copy()},
+	}
+	e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, &resp)
+	return resp
+}
@@ -0,0 +1,101 @@
+// Package eventtypes resolves the event types that notification settings
+// subscribe to, including group wildcards such as `subscription.*`.
+package eventtypes
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Suffix of a pattern that matches every event type of an entity.
+const wildcardSuffix = ".*"
+
+//go:embed event_types.json
+var embeddedJSON []byte
+
+var embedded = sync.OnceValue(func() []*paddle.EventType {
+	var eventTypes []*paddle.EventType
+	if err := json.Unmarshal(embeddedJSON, &eventTypes); err != nil {
+		panic(fmt.Sprintf("eventtypes: invalid embedded event types: %s", err))
+	}
+	return eventTypes
+})
+
+// Returns the event types Paddle could send when the provider was built. They
+// are used when the event types API cannot be reached.
+func Embedded() []*paddle.EventType {
+	return embedded()
+}
+
+// Reports whether pattern is a wildcard such as `subscription.*`.
+func IsWildcard(pattern string) bool {
+	return strings.HasSuffix(pattern, wildcardSuffix)
+}
+
+// Returns the entity of an event type name, such as `subscription` for
+// `subscription.created`.
+func entity(name string) string {
+	entity, _, _ := strings.Cut(name, ".")
+	return entity
+}
+
+// Resolves event type names and wildcards against the known event types.
+// It returns the event type names in the order of the patterns, without
+// duplicates, and the patterns that match no known event type.
+func Expand(patterns []string, known []*paddle.EventType) (names []string, unknown []string) {
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, pattern := range patterns {
+		matched := false
+		for _, eventType := range known {
+			name := string(eventType.Name)
+			if name == pattern || (IsWildcard(pattern) && entity(name) == strings.TrimSuffix(pattern, wildcardSuffix)) {
+				add(name)
+				matched = true
+			}
+		}
+		if !matched {
+			unknown = append(unknown, pattern)
+		}
+	}
+
+	return names, unknown
+}
+
+// Explains why a pattern matches no known event type, listing the event types
+// of its entity when there are some, or the known wildcards otherwise.
+func Hint(pattern string, known []*paddle.EventType) string {
+	var siblings []string
+	wildcards := map[string]bool{}
+	for _, eventType := range known {
+		name := string(eventType.Name)
+		wildcards[entity(name)+wildcardSuffix] = true
+		if entity(name) == entity(pattern) {
+			siblings = append(siblings, name)
+		}
+	}
+
+	if len(siblings) > 0 && !IsWildcard(pattern) {
+		sort.Strings(siblings)
+		return fmt.Sprintf("%q is not a Paddle event type. Event types for %s: %s.", pattern, entity(pattern), strings.Join(siblings, ", "))
+	}
+
+	groups := make([]string, 0, len(wildcards))
+	for wildcard := range wildcards {
+		groups = append(groups, wildcard)
+	}
+	sort.Strings(groups)
+	return fmt.Sprintf("%q matches no Paddle event type. Known wildcards: %s.", pattern, strings.Join(groups, ", "))
+}
@@ -0,0 +1,105 @@
+package eventtypes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+var testKnown = []*paddle.EventType{
+	{Name: "subscription.created", Group: "Subscription"},
+	{Name: "subscription.updated", Group: "Subscription"},
+	{Name: "transaction.completed", Group: "Transaction"},
+}
+
+func TestEmbedded(t *testing.T) {
+	eventTypes := Embedded()
+	if len(eventTypes) == 0 {
+		t.Fatal("expected embedded event types")
+	}
+
+	seen := map[paddle.EventTypeName]bool{}
+	for _, eventType := range eventTypes {
+		if eventType.Name == "" || eventType.Group == "" || eventType.Description == "" || len(eventType.AvailableVersions) == 0 {
+			t.Errorf("incomplete embedded event type: %+v", eventType)
+		}
+		if seen[eventType.Name] {
+			t.Errorf("duplicate embedded event type %s", eventType.Name)
+		}
+		seen[eventType.Name] = true
+	}
+
+	// Every event type named by the SDK must be known.
+	for _, name := range []paddle.EventTypeName{
+		paddle.EventTypeNameAddressCreated,
+		paddle.EventTypeNameClientTokenRevoked,
+		paddle.EventTypeNameSubscriptionTrialing,
+		paddle.EventTypeNameTransactionPaymentFailed,
+	} {
+		if !seen[name] {
+			t.Errorf("missing embedded event type %s", name)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		names    []string
+		unknown  []string
+	}{
+		{
+			name:     "names",
+			patterns: []string{"transaction.completed", "subscription.created"},
+			names:    []string{"transaction.completed", "subscription.created"},
+		},
+		{
+			name:     "wildcard",
+			patterns: []string{"subscription.*"},
+			names:    []string{"subscription.created", "subscription.updated"},
+		},
+		{
+			name:     "duplicates",
+			patterns: []string{"subscription.updated", "subscription.*"},
+			names:    []string{"subscription.updated", "subscription.created"},
+		},
+		{
+			name:     "unknown",
+			patterns: []string{"transaction.complete", "payout.*", "transaction.completed"},
+			names:    []string{"transaction.completed"},
+			unknown:  []string{"transaction.complete", "payout.*"},
+		},
+		{
+			name:     "entity prefix is not a wildcard",
+			patterns: []string{"subscription"},
+			unknown:  []string{"subscription"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, unknown := Expand(tt.patterns, testKnown)
+			if !reflect.DeepEqual(names, tt.names) {
+				t.Errorf("expected names %v, got %v", tt.names, names)
+			}
+			if !reflect.DeepEqual(unknown, tt.unknown) {
+				t.Errorf("expected unknown %v, got %v", tt.unknown, unknown)
+			}
+		})
+	}
+}
+
+func TestHint(t *testing.T) {
+	hint := Hint("transaction.complete", testKnown)
+	if !strings.Contains(hint, "transaction.completed") {
+		t.Errorf("expected the event types of the entity, got %q", hint)
+	}
+
+	hint = Hint("payout.*", testKnown)
+	if !strings.Contains(hint, "subscription.*, transaction.*") {
+		t.Errorf("expected the known wildcards, got %q", hint)
+	}
+}
@@ -0,0 +1,133 @@
+package functions_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Runs a function with arguments, and returns its result.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	var def function.DefinitionResponse
+	f.Definition(context.Background(), function.DefinitionRequest{}, &def)
+	parameters := len(def.Definition.Parameters)
+	if len(args) < parameters || (def.Definition.VariadicParameter == nil && len(args) != parameters) {
+		t.Fatalf("expected %d arguments, got %d", parameters, len(args))
+	}
+
+	// Variadic arguments are passed to the function as a single tuple
+	arguments := append([]attr.Value{}, args[:parameters]...)
+	if def.Definition.VariadicParameter != nil {
+		elementTypes := make([]attr.Type, len(args)-parameters)
+		for i := range elementTypes {
+			elementTypes[i] = def.Definition.VariadicParameter.GetType()
+		}
+		arguments = append(arguments, types.TupleValueMust(elementTypes, args[parameters:]))
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(def.Definition.Return.GetType().ValueType(context.Background()))}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+const (
+	testSecret = "pdl_ntfset_01gkpjp8bkm3tm53kdgkx6sms7_6h3qd3uFSi9YCD3OLYAShQI90XTI5vEI"
+	testBody   = `{"event_id":"evt_01gks14ge726w50ce2rdt1ft0a","event_type":"transaction.completed"}`
+)
+
+func TestWebhookSignatureFunction(t *testing.T) {
+	result, err := runFunction(t, functions.NewWebhookSignatureFunction(), types.StringValue(testSecret), types.Int64Value(1671552777), types.StringValue(testBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := result.(types.String).ValueString()
+	if !strings.HasPrefix(header, "ts=1671552777;h1=") || len(header) != len("ts=1671552777;h1=")+64 {
+		t.Errorf("expected a Paddle-Signature header, got %s", header)
+	}
+
+	// The signature verifies
+	verified, err := runFunction(t, functions.NewVerifyWebhookSignatureFunction(), types.StringValue(testSecret), types.StringValue(header), types.StringValue(testBody), types.StringNull())
+	if err != nil || !verified.(types.Bool).ValueBool() {
+		t.Errorf("expected the signature to verify, got %v (%v)", verified, err)
+	}
+}
+
+func TestVerifyWebhookSignatureFunction(t *testing.T) {
+	sign := func(secret string, at time.Time, body string) types.String {
+		result, err := runFunction(t, functions.NewWebhookSignatureFunction(), types.StringValue(secret), types.Int64Value(at.Unix()), types.StringValue(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result.(types.String)
+	}
+	signedAt := time.Date(2024, 4, 12, 10, 17, 49, 0, time.UTC)
+	now := types.StringValue("2024-04-12T10:18:49Z")
+
+	for name, tc := range map[string]struct {
+		header    types.String
+		body      string
+		tolerance types.String
+		now       types.String
+		expected  bool
+	}{
+		"valid":            {header: sign(testSecret, signedAt, testBody), body: testBody, tolerance: types.StringNull(), now: types.StringNull(), expected: true},
+		"no tolerance":     {header: sign(testSecret, signedAt, testBody), body: testBody, tolerance: types.StringValue("0s"), now: types.StringNull(), expected: true},
+		"within tolerance": {header: sign(testSecret, signedAt, testBody), body: testBody, tolerance: types.StringValue("5m"), now: now, expected: true},
+		"replayed":         {header: sign(testSecret, signedAt, testBody), body: testBody, tolerance: types.StringValue("5s"), now: now},
+		"wrong secret":     {header: sign("pdl_ntfset_other", signedAt, testBody), body: testBody, tolerance: types.StringNull(), now: types.StringNull()},
+		"tampered body":    {header: sign(testSecret, signedAt, testBody), body: `{"event_id":"evt_02"}`, tolerance: types.StringNull(), now: types.StringNull()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			args := []attr.Value{types.StringValue(testSecret), tc.header, types.StringValue(tc.body), tc.tolerance}
+			if !tc.now.IsNull() {
+				args = append(args, tc.now)
+			}
+			result, err := runFunction(t, functions.NewVerifyWebhookSignatureFunction(), args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.(types.Bool).ValueBool() != tc.expected {
+				t.Errorf("expected %t, got %s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookSignatureFunction_invalidArguments(t *testing.T) {
+	header := "ts=" + strconv.FormatInt(time.Now().Unix(), 10) + ";h1=00"
+
+	for name, tc := range map[string]struct {
+		header    string
+		tolerance types.String
+		now       []attr.Value
+		argument  int64
+	}{
+		"malformed header":   {header: "h1=00", tolerance: types.StringNull(), argument: 1},
+		"invalid tolerance":  {header: header, tolerance: types.StringValue("5 minutes"), argument: 3},
+		"negative tolerance": {header: header, tolerance: types.StringValue("-5s"), argument: 3},
+		"missing now":        {header: header, tolerance: types.StringValue("5m"), argument: 4},
+		"null now":           {header: header, tolerance: types.StringValue("5m"), now: []attr.Value{types.StringNull()}, argument: 4},
+		"invalid now":        {header: header, tolerance: types.StringValue("5m"), now: []attr.Value{types.StringValue("yesterday")}, argument: 4},
+		"several now": {header: header, tolerance: types.StringValue("5m"), now: []attr.Value{
+			types.StringValue("2024-04-12T10:18:49Z"), types.StringValue("2024-04-12T10:18:50Z"),
+		}, argument: 4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			args := append([]attr.Value{types.StringValue(testSecret), types.StringValue(tc.header), types.StringValue(testBody), tc.tolerance}, tc.now...)
+			_, err := runFunction(t, functions.NewVerifyWebhookSignatureFunction(), args...)
+			if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != tc.argument {
+				t.Errorf("expected an error on argument %d, got %v", tc.argument, err)
+			}
+		})
+	}
+}
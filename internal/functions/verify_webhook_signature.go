@@ -0,0 +1,118 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/webhooks"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &VerifyWebhookSignatureFunction{}
+
+// Creates a new webhook signature verification function.
+func NewVerifyWebhookSignatureFunction() function.Function {
+	return &VerifyWebhookSignatureFunction{}
+}
+
+// Verifies a Paddle-Signature header against a webhook body.
+type VerifyWebhookSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *VerifyWebhookSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_webhook_signature"
+}
+
+// Definition returns the function parameters and return type.
+func (f *VerifyWebhookSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Verifies a Paddle webhook signature",
+		MarkdownDescription: "Returns whether a `Paddle-Signature` header is a valid signature of a webhook body with the `endpoint_secret_key` of a notification setting. " +
+			"Paddle sends several `h1` signatures while a secret is rotated, and the header is valid when any of them matches. " +
+			"A malformed header is an error.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secret",
+				MarkdownDescription: "Endpoint secret key of the notification setting (format: pdl_ntfset_...).",
+			},
+			function.StringParameter{
+				Name:                "header",
+				MarkdownDescription: "Value of the `Paddle-Signature` header, such as `ts=1671552777;h1=eb4d0dc8...`.",
+			},
+			function.StringParameter{
+				Name:                "body",
+				MarkdownDescription: "Raw webhook body, exactly as received.",
+			},
+			function.StringParameter{
+				Name:           "tolerance",
+				AllowNullValue: true,
+				MarkdownDescription: "How far the header timestamp may be from `now`, as a duration such as `5s` or `5m`, to reject replayed webhooks. " +
+					"`null` or `0s` skips the timestamp check.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:           "now",
+			AllowNullValue: true,
+			MarkdownDescription: "RFC 3339 timestamp the header timestamp is checked against, such as `plantimestamp()`. Required when `tolerance` is positive, and at most one can be given. " +
+				"It is an argument rather than the current time, so that the function returns the same result at plan and apply.",
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run verifies the signature header.
+func (f *VerifyWebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secret, header, body string
+	var tolerance types.String
+	var nowValues []types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &secret, &header, &body, &tolerance, &nowValues))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(nowValues) > 1 {
+		resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("now must be given at most once. Got %d values.", len(nowValues)))
+		return
+	}
+	nowValue := types.StringNull()
+	if len(nowValues) == 1 {
+		nowValue = nowValues[0]
+	}
+
+	var duration time.Duration
+	if !tolerance.IsNull() {
+		var err error
+		duration, err = time.ParseDuration(tolerance.ValueString())
+		if err != nil || duration < 0 {
+			resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("tolerance must be a positive duration, such as 5s or 5m. Got: %s", tolerance.ValueString()))
+			return
+		}
+	}
+
+	var now time.Time
+	if duration > 0 {
+		if nowValue.IsNull() {
+			resp.Error = function.NewArgumentFuncError(4, "now must be set when tolerance is positive, for example to plantimestamp().")
+			return
+		}
+		var err error
+		now, err = time.Parse(time.RFC3339, nowValue.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("now must be an RFC 3339 timestamp, such as 2024-04-12T10:18:49Z. Got: %s", nowValue.ValueString()))
+			return
+		}
+	}
+
+	err := webhooks.Verify(secret, header, []byte(body), duration, now)
+	if errors.Is(err, webhooks.ErrMalformedHeader) {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, err == nil))
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/webhooks"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &WebhookSignatureFunction{}
+
+// Creates a new webhook signature function.
+func NewWebhookSignatureFunction() function.Function {
+	return &WebhookSignatureFunction{}
+}
+
+// Computes the Paddle-Signature header Paddle sends with a webhook body.
+type WebhookSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *WebhookSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_signature"
+}
+
+// Definition returns the function parameters and return type.
+func (f *WebhookSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Computes a Paddle webhook signature",
+		MarkdownDescription: "Returns the `Paddle-Signature` header Paddle would send with a webhook body at a timestamp, signed with the `endpoint_secret_key` of a notification setting: `ts=<timestamp>;h1=<HMAC-SHA256 of timestamp:body>`.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secret",
+				MarkdownDescription: "Endpoint secret key of the notification setting (format: pdl_ntfset_...).",
+			},
+			function.Int64Parameter{
+				Name:                "timestamp",
+				MarkdownDescription: "Unix timestamp, in seconds, the webhook is signed at.",
+			},
+			function.StringParameter{
+				Name:                "body",
+				MarkdownDescription: "Raw webhook body, exactly as sent.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the signature header.
+func (f *WebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secret, body string
+	var timestamp int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &secret, &timestamp, &body))
+	if resp.Error != nil {
+		return
+	}
+
+	header := webhooks.Sign(secret, time.Unix(timestamp, 0), []byte(body))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, header))
+}
@@ -0,0 +1,128 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/transport"
+	"github.com/PaddleHQ/paddle-go-sdk/v4/pkg/paddleerr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Matches one segment of a Paddle field name, such as `subscribed_events[2]`.
+var apiFieldSegmentRegex = regexp.MustCompile(`^([^\[\]]*)((?:\[\d+\])*)$`)
+
+// Adds the diagnostics for a failed Paddle API call.
+// When err is a Paddle error, each field error is attached to the matching
+// attribute of schemaType, and every diagnostic carries the error code, request
+// ID and documentation link. Field errors that do not match an attribute, and
+// errors without field errors, are reported as a single error. schemaType may be
+// nil when the call is not tied to an attribute, such as when listing resources.
+func AddAPIError(diags *diag.Diagnostics, schemaType attr.Type, summary, detail string, err error) {
+	var apiErr *paddleerr.Error
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, fmt.Sprintf("%s: %s", detail, err.Error()))
+		return
+	}
+
+	reference := apiErrorReference(apiErr)
+	unmatched := []string{}
+	for _, fieldErr := range apiErr.Errors {
+		if p, ok := APIFieldPath(schemaType, fieldErr.Field); ok {
+			diags.AddAttributeError(p, summary, fmt.Sprintf("%s: %s%s", detail, fieldErr.Message, reference))
+			continue
+		}
+		unmatched = append(unmatched, fmt.Sprintf("\n  - %s: %s", fieldErr.Field, fieldErr.Message))
+	}
+
+	if len(apiErr.Errors) > 0 && len(unmatched) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("%s: %s.%s", detail, apiErr.Type, apiErr.Code)
+	if apiErr.Detail != "" {
+		message += ": " + apiErr.Detail
+	}
+	diags.AddError(summary, message+strings.Join(unmatched, "")+reference)
+}
+
+// Returns the error code, request ID and documentation link of a Paddle error,
+// formatted to be appended to a diagnostic detail.
+func apiErrorReference(apiErr *paddleerr.Error) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("\n\nPaddle error code: %s", apiErr.Code))
+	if requestID, ok := apiErr.Extra[transport.ErrorRequestIDKey].(string); ok && requestID != "" {
+		sb.WriteString(fmt.Sprintf("\nRequest ID: %s", requestID))
+	}
+	if apiErr.DocumentationURL != "" {
+		sb.WriteString(fmt.Sprintf("\nDocumentation: %s", apiErr.DocumentationURL))
+	}
+
+	return sb.String()
+}
+
+// Converts a Paddle field name, such as `unit_price.amount` or
+// `subscribed_events[2]`, to the matching path in schemaType. Returns false when
+// the field does not exist in the schema.
+func APIFieldPath(schemaType attr.Type, field string) (path.Path, bool) {
+	if schemaType == nil || field == "" {
+		return path.Empty(), false
+	}
+
+	// Split the field into attribute names and list indexes
+	steps := []string{}
+	for _, segment := range strings.Split(field, ".") {
+		match := apiFieldSegmentRegex.FindStringSubmatch(segment)
+		if match == nil {
+			return path.Empty(), false
+		}
+		if match[1] != "" {
+			steps = append(steps, match[1])
+		}
+		for _, index := range strings.Split(match[2], "[") {
+			if index != "" {
+				steps = append(steps, strings.TrimSuffix(index, "]"))
+			}
+		}
+	}
+
+	p := path.Empty()
+	current := schemaType
+	for _, step := range steps {
+		switch t := current.(type) {
+		case attr.TypeWithAttributeTypes:
+			attrType, ok := t.AttributeTypes()[step]
+			if !ok {
+				return path.Empty(), false
+			}
+			p = p.AtName(step)
+			current = attrType
+		case attr.TypeWithElementType:
+			if _, ok := t.(basetypes.MapTypable); ok {
+				p = p.AtMapKey(step)
+				current = t.ElementType()
+				continue
+			}
+			if _, ok := t.(basetypes.ListTypable); !ok {
+				return path.Empty(), false
+			}
+			index, err := strconv.Atoi(step)
+			if err != nil {
+				return path.Empty(), false
+			}
+			p = p.AtListIndex(index)
+			current = t.ElementType()
+		default:
+			return path.Empty(), false
+		}
+	}
+
+	return p, len(steps) > 0
+}
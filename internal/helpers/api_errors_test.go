@@ -0,0 +1,164 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/transport"
+	"github.com/PaddleHQ/paddle-go-sdk/v4/pkg/paddleerr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Schema type shaped like the price, discount and notification setting resources.
+var testSchemaType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"code":              types.StringType,
+		"subscribed_events": types.ListType{ElemType: types.StringType},
+		"custom_data":       types.MapType{ElemType: types.StringType},
+		"unit_price": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"amount":        types.StringType,
+			"currency_code": types.StringType,
+		}},
+		"unit_price_overrides": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"country_codes": types.ListType{ElemType: types.StringType},
+		}}},
+	},
+}
+
+func TestAPIFieldPath(t *testing.T) {
+	tests := []struct {
+		field    string
+		expected path.Path
+		ok       bool
+	}{
+		{field: "code", expected: path.Root("code"), ok: true},
+		{field: "unit_price.amount", expected: path.Root("unit_price").AtName("amount"), ok: true},
+		{field: "subscribed_events[2]", expected: path.Root("subscribed_events").AtListIndex(2), ok: true},
+		{field: "subscribed_events.2", expected: path.Root("subscribed_events").AtListIndex(2), ok: true},
+		{field: "custom_data.tier", expected: path.Root("custom_data").AtMapKey("tier"), ok: true},
+		{
+			field:    "unit_price_overrides[0].country_codes[1]",
+			expected: path.Root("unit_price_overrides").AtListIndex(0).AtName("country_codes").AtListIndex(1),
+			ok:       true,
+		},
+		{field: "billing_cycle.interval", ok: false},
+		{field: "unit_price.amount.value", ok: false},
+		{field: "subscribed_events.first", ok: false},
+		{field: "(root)", ok: false},
+		{field: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			result, ok := APIFieldPath(testSchemaType, tt.field)
+
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v but got %v", tt.ok, ok)
+			}
+			if ok && !result.Equal(tt.expected) {
+				t.Fatalf("expected path %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAddAPIError(t *testing.T) {
+	apiErr := &paddleerr.Error{
+		Type:             paddleerr.ErrorTypeRequestError,
+		Code:             "bad_request",
+		Detail:           "Invalid request.",
+		DocumentationURL: "https://developer.paddle.com/errors/shared/bad_request",
+		Extra:            map[string]any{transport.ErrorRequestIDKey: "b15ec92e-8688-40d4-a04d-a4a1e6e5ea64"},
+	}
+
+	t.Run("field errors", func(t *testing.T) {
+		fieldErr := *apiErr
+		fieldErr.Errors = []paddleerr.ValidationError{
+			{Field: "unit_price.amount", Message: "must be a valid number"},
+			{Field: "subscribed_events[2]", Message: "unknown event type"},
+		}
+
+		var diags diag.Diagnostics
+		AddAPIError(&diags, testSchemaType, "Error creating price", "Could not create price", &fieldErr)
+
+		if diags.ErrorsCount() != 2 {
+			t.Fatalf("expected 2 errors but got %d: %v", diags.ErrorsCount(), diags)
+		}
+		expectedPaths := []path.Path{
+			path.Root("unit_price").AtName("amount"),
+			path.Root("subscribed_events").AtListIndex(2),
+		}
+		for i, d := range diags {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(expectedPaths[i]) {
+				t.Fatalf("expected diagnostic on %s but got %v", expectedPaths[i], d)
+			}
+			assertAPIErrorReference(t, d.Detail())
+		}
+	})
+
+	t.Run("unmatched field errors", func(t *testing.T) {
+		fieldErr := *apiErr
+		fieldErr.Errors = []paddleerr.ValidationError{
+			{Field: "billing_period", Message: "is not supported"},
+		}
+
+		var diags diag.Diagnostics
+		AddAPIError(&diags, testSchemaType, "Error creating price", "Could not create price", &fieldErr)
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected 1 error but got %d", diags.ErrorsCount())
+		}
+		if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+			t.Fatal("expected diagnostic without attribute path")
+		}
+		if !strings.Contains(diags[0].Detail(), "billing_period: is not supported") {
+			t.Fatalf("expected field error in detail but got %q", diags[0].Detail())
+		}
+		assertAPIErrorReference(t, diags[0].Detail())
+	})
+
+	t.Run("error without field errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		AddAPIError(&diags, nil, "Error reading price", "Could not read price ID pri_123", apiErr)
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected 1 error but got %d", diags.ErrorsCount())
+		}
+		if !strings.HasPrefix(diags[0].Detail(), "Could not read price ID pri_123: request_error.bad_request: Invalid request.") {
+			t.Fatalf("unexpected detail %q", diags[0].Detail())
+		}
+		assertAPIErrorReference(t, diags[0].Detail())
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		AddAPIError(&diags, testSchemaType, "Error reading price", "Could not read price ID pri_123", errors.New("connection refused"))
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected 1 error but got %d", diags.ErrorsCount())
+		}
+		if diags[0].Detail() != "Could not read price ID pri_123: connection refused" {
+			t.Fatalf("unexpected detail %q", diags[0].Detail())
+		}
+	})
+}
+
+// Checks that a diagnostic detail carries the Paddle error reference.
+func assertAPIErrorReference(t *testing.T, detail string) {
+	t.Helper()
+
+	for _, expected := range []string{
+		"Paddle error code: bad_request",
+		"Request ID: b15ec92e-8688-40d4-a04d-a4a1e6e5ea64",
+		"Documentation: https://developer.paddle.com/errors/shared/bad_request",
+	} {
+		if !strings.Contains(detail, expected) {
+			t.Fatalf("expected %q in detail %q", expected, detail)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package paddleclient provides the client shared by every resource and data source.
+package paddleclient
+
+import (
+	"context"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Statuses requested when reading entities through list calls, so that archived
+// entities are returned like they are by the get endpoints.
+var listStatuses = []string{
+	string(paddle.StatusActive),
+	string(paddle.StatusArchived),
+}
+
+// Client wraps the Paddle SDK with per-provider read coalescing.
+// It is passed as provider data to every resource and data source, and
+// embeds the SDK so all of its operations remain available.
+type Client struct {
+	*paddle.SDK
+
+	// Paddle environment the SDK calls: "sandbox" or "production".
+	Environment string
+
+	prices     *coalescer[paddle.Price]
+	products   *coalescer[paddle.Product]
+	eventTypes eventTypeCache
+}
+
+// Creates a client around the given SDK, which calls the given environment.
+func New(sdk *paddle.SDK, environment string) *Client {
+	return &Client{
+		SDK:         sdk,
+		Environment: environment,
+		prices: newCoalescer(
+			func(ctx context.Context, ids []string) (map[string]*paddle.Price, error) {
+				perPage := len(ids)
+				prices, err := sdk.ListPrices(ctx, &paddle.ListPricesRequest{
+					ID:      ids,
+					Status:  listStatuses,
+					PerPage: &perPage,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				found := make(map[string]*paddle.Price, len(ids))
+				err = prices.Iter(ctx, func(p *paddle.Price) (bool, error) {
+					found[p.ID] = p
+					return true, nil
+				})
+				return found, err
+			},
+			func(ctx context.Context, id string) (*paddle.Price, error) {
+				return sdk.GetPrice(ctx, &paddle.GetPriceRequest{PriceID: id})
+			},
+		),
+		products: newCoalescer(
+			func(ctx context.Context, ids []string) (map[string]*paddle.Product, error) {
+				perPage := len(ids)
+				products, err := sdk.ListProducts(ctx, &paddle.ListProductsRequest{
+					ID:      ids,
+					Status:  listStatuses,
+					PerPage: &perPage,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				found := make(map[string]*paddle.Product, len(ids))
+				err = products.Iter(ctx, func(p *paddle.Product) (bool, error) {
+					found[p.ID] = p
+					return true, nil
+				})
+				return found, err
+			},
+			func(ctx context.Context, id string) (*paddle.Product, error) {
+				return sdk.GetProduct(ctx, &paddle.GetProductRequest{ProductID: id})
+			},
+		),
+	}
+}
+
+// Reads a price, batching concurrent reads into a single list call and
+// caching the result for the lifetime of the provider.
+func (c *Client) ReadPrice(ctx context.Context, id string) (*paddle.Price, error) {
+	return c.prices.get(ctx, id)
+}
+
+// Reads a product, batching concurrent reads into a single list call and
+// caching the result for the lifetime of the provider.
+func (c *Client) ReadProduct(ctx context.Context, id string) (*paddle.Product, error) {
+	return c.products.get(ctx, id)
+}
+
+// Removes a price from the read cache, after it was created, updated or archived.
+func (c *Client) ForgetPrice(id string) {
+	c.prices.forget(id)
+}
+
+// Removes a product from the read cache, after it was created, updated or archived.
+func (c *Client) ForgetProduct(id string) {
+	c.products.forget(id)
+}
@@ -0,0 +1,157 @@
+package paddleclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// How long a batch waits for more reads before its list call is sent.
+	coalesceWindow = 20 * time.Millisecond
+
+	// Maximum number of IDs in one list call, matching the Paddle page limit.
+	maxBatchSize = 200
+
+	// Upper bound for a list call, as it runs detached from any single read.
+	batchTimeout = time.Minute
+)
+
+// Reads that are merged into a single list call.
+type batch[T any] struct {
+	ids   []string
+	found map[string]*T
+	err   error
+	done  chan struct{}
+}
+
+// Merges concurrent reads of entities of one type into list calls filtered by ID,
+// and caches every entity it reads.
+type coalescer[T any] struct {
+	fetchMany func(ctx context.Context, ids []string) (map[string]*T, error)
+	fetchOne  func(ctx context.Context, id string) (*T, error)
+
+	mu      sync.Mutex
+	pending *batch[T]
+	cache   map[string]*T
+}
+
+// Creates a coalescer from the list and get calls of an entity type.
+// fetchMany must return the entities found among ids, keyed by ID.
+func newCoalescer[T any](
+	fetchMany func(ctx context.Context, ids []string) (map[string]*T, error),
+	fetchOne func(ctx context.Context, id string) (*T, error),
+) *coalescer[T] {
+	return &coalescer[T]{
+		fetchMany: fetchMany,
+		fetchOne:  fetchOne,
+		cache:     map[string]*T{},
+	}
+}
+
+// Reads one entity. Entities missing from the list call, or read while the list
+// call failed, are fetched on their own so that errors such as not found are
+// reported for the entity that caused them.
+func (c *coalescer[T]) get(ctx context.Context, id string) (*T, error) {
+	c.mu.Lock()
+	if entity, ok := c.cache[id]; ok {
+		c.mu.Unlock()
+		return entity, nil
+	}
+	b := c.join(ctx, id)
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.done:
+	}
+
+	if entity, ok := b.found[id]; ok && b.err == nil {
+		return entity, nil
+	}
+
+	entity, err := c.fetchOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.store(id, entity)
+	return entity, nil
+}
+
+// Adds an ID to the pending batch, starting a new batch when there is none and
+// sending the pending one as soon as it is full. Must be called with the lock
+// held.
+func (c *coalescer[T]) join(ctx context.Context, id string) *batch[T] {
+	if c.pending == nil {
+		b := &batch[T]{done: make(chan struct{})}
+		c.pending = b
+		time.AfterFunc(coalesceWindow, func() { c.flush(context.WithoutCancel(ctx), b) })
+	}
+
+	b := c.pending
+	b.ids = append(b.ids, id)
+	if len(b.ids) == maxBatchSize {
+		c.pending = nil
+		go c.send(context.WithoutCancel(ctx), b, deduplicate(b.ids))
+	}
+	return b
+}
+
+// Sends a batch when its window ends. Flushing a batch that was already sent
+// does nothing.
+func (c *coalescer[T]) flush(ctx context.Context, b *batch[T]) {
+	c.mu.Lock()
+	if c.pending != b {
+		c.mu.Unlock()
+		return
+	}
+	c.pending = nil
+	ids := deduplicate(b.ids)
+	c.mu.Unlock()
+
+	c.send(ctx, b, ids)
+}
+
+// Sends the list call of a batch no longer pending and wakes up its reads.
+func (c *coalescer[T]) send(ctx context.Context, b *batch[T], ids []string) {
+	ctx, cancel := context.WithTimeout(ctx, batchTimeout)
+	defer cancel()
+
+	b.found, b.err = c.fetchMany(ctx, ids)
+	if b.err == nil {
+		c.mu.Lock()
+		for id, entity := range b.found {
+			c.cache[id] = entity
+		}
+		c.mu.Unlock()
+	}
+	close(b.done)
+}
+
+// Caches one entity.
+func (c *coalescer[T]) store(id string, entity *T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache[id] = entity
+}
+
+// Removes one entity from the cache.
+func (c *coalescer[T]) forget(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cache, id)
+}
+
+// Returns ids without duplicates, keeping their order.
+func deduplicate(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,212 @@
+package paddleclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+)
+
+type testEntity struct {
+	ID string
+}
+
+// Records the calls made by a coalescer to fake list and get calls.
+type fakeAPI struct {
+	mu       sync.Mutex
+	lists    [][]string
+	gets     []string
+	missing  map[string]bool
+	listErr  error
+	notFound error
+}
+
+func (f *fakeAPI) fetchMany(ctx context.Context, ids []string) (map[string]*testEntity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+	f.lists = append(f.lists, sorted)
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+
+	found := map[string]*testEntity{}
+	for _, id := range ids {
+		if !f.missing[id] {
+			found[id] = &testEntity{ID: id}
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeAPI) fetchOne(ctx context.Context, id string) (*testEntity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.gets = append(f.gets, id)
+	if f.missing[id] {
+		return nil, f.notFound
+	}
+	return &testEntity{ID: id}, nil
+}
+
+// Reads ids concurrently and returns the errors by ID.
+func readConcurrently(c *coalescer[testEntity], ids []string) map[string]error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := map[string]error{}
+
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			entity, err := c.get(context.Background(), id)
+			if err == nil && entity.ID != id {
+				err = fmt.Errorf("expected entity %s but got %s", id, entity.ID)
+			}
+			mu.Lock()
+			errs[id] = err
+			mu.Unlock()
+		}(id)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestCoalescer_batchesConcurrentReads(t *testing.T) {
+	api := &fakeAPI{}
+	c := newCoalescer(api.fetchMany, api.fetchOne)
+
+	ids := []string{"pri_01", "pri_02", "pri_03", "pri_02"}
+	for id, err := range readConcurrently(c, ids) {
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", id, err)
+		}
+	}
+
+	if len(api.lists) != 1 {
+		t.Fatalf("expected 1 list call but got %d: %v", len(api.lists), api.lists)
+	}
+	if fmt.Sprint(api.lists[0]) != "[pri_01 pri_02 pri_03]" {
+		t.Fatalf("expected list call for each ID once but got %v", api.lists[0])
+	}
+	if len(api.gets) != 0 {
+		t.Fatalf("expected no get calls but got %v", api.gets)
+	}
+
+	// Repeat reads are served from the cache
+	if _, err := c.get(context.Background(), "pri_02"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(api.lists) != 1 || len(api.gets) != 0 {
+		t.Fatalf("expected cached read but got lists %v and gets %v", api.lists, api.gets)
+	}
+
+	// Forgotten entities are read again
+	c.forget("pri_02")
+	if _, err := c.get(context.Background(), "pri_02"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(api.lists) != 2 {
+		t.Fatalf("expected a new list call but got %v", api.lists)
+	}
+}
+
+func TestCoalescer_splitsLargeBatches(t *testing.T) {
+	api := &fakeAPI{}
+	c := newCoalescer(api.fetchMany, api.fetchOne)
+
+	ids := make([]string, maxBatchSize+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("pro_%03d", i)
+	}
+	readConcurrently(c, ids)
+
+	if len(api.lists) != 2 {
+		t.Fatalf("expected 2 list calls but got %d", len(api.lists))
+	}
+	for _, list := range api.lists {
+		if len(list) > maxBatchSize {
+			t.Fatalf("expected at most %d IDs per list call but got %d", maxBatchSize, len(list))
+		}
+	}
+}
+
+func TestCoalescer_concurrentReadsStayWithinBatchSize(t *testing.T) {
+	api := &fakeAPI{}
+	c := newCoalescer(api.fetchMany, api.fetchOne)
+
+	ids := make([]string, 5*maxBatchSize+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("pri_%04d", i)
+	}
+	// Every read waits for the others to start, so that reads keep joining
+	// while full batches are sent
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			<-start
+			if _, err := c.get(context.Background(), id); err != nil {
+				t.Errorf("unexpected error for %s: %v", id, err)
+			}
+		}(id)
+	}
+	close(start)
+	wg.Wait()
+
+	listed := 0
+	for _, list := range api.lists {
+		if len(list) > maxBatchSize {
+			t.Fatalf("expected at most %d IDs per list call but got %d", maxBatchSize, len(list))
+		}
+		listed += len(list)
+	}
+	if listed != len(ids) {
+		t.Fatalf("expected %d listed IDs but got %d", len(ids), listed)
+	}
+	if len(api.gets) != 0 {
+		t.Fatalf("expected no get calls but got %d", len(api.gets))
+	}
+}
+
+func TestCoalescer_fallsBackToGet(t *testing.T) {
+	notFound := errors.New("not found")
+
+	t.Run("missing from list", func(t *testing.T) {
+		api := &fakeAPI{missing: map[string]bool{"pri_02": true}, notFound: notFound}
+		c := newCoalescer(api.fetchMany, api.fetchOne)
+
+		errs := readConcurrently(c, []string{"pri_01", "pri_02"})
+		if errs["pri_01"] != nil {
+			t.Fatalf("unexpected error: %v", errs["pri_01"])
+		}
+		if !errors.Is(errs["pri_02"], notFound) {
+			t.Fatalf("expected not found error but got %v", errs["pri_02"])
+		}
+		if fmt.Sprint(api.gets) != "[pri_02]" {
+			t.Fatalf("expected get call for the missing ID only but got %v", api.gets)
+		}
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		api := &fakeAPI{listErr: errors.New("bad gateway")}
+		c := newCoalescer(api.fetchMany, api.fetchOne)
+
+		for id, err := range readConcurrently(c, []string{"pri_01", "pri_02"}) {
+			if err != nil {
+				t.Fatalf("unexpected error for %s: %v", id, err)
+			}
+		}
+		if len(api.gets) != 2 {
+			t.Fatalf("expected a get call for each ID but got %v", api.gets)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package paddleclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Event types read from the event types API, shared by every resource.
+type eventTypeCache struct {
+	mu         sync.Mutex
+	eventTypes []*paddle.EventType
+}
+
+// Returns the event types Paddle can send, read from the event types API once
+// per provider so that event types added after the provider was built are known.
+// When the API cannot be reached, the event types embedded in the provider are
+// returned instead, and the next call tries the API again.
+func (c *Client) KnownEventTypes(ctx context.Context) []*paddle.EventType {
+	c.eventTypes.mu.Lock()
+	cached := c.eventTypes.eventTypes
+	c.eventTypes.mu.Unlock()
+	if cached != nil {
+		return cached
+	}
+
+	eventTypes, err := c.ListAllEventTypes(ctx)
+	if err != nil || len(eventTypes) == 0 {
+		tflog.Warn(ctx, "Could not read Paddle event types, using the event types known to the provider", map[string]any{
+			"error": err,
+		})
+		return eventtypes.Embedded()
+	}
+
+	c.eventTypes.mu.Lock()
+	c.eventTypes.eventTypes = eventTypes
+	c.eventTypes.mu.Unlock()
+	return eventTypes
+}
+
+// Reads every event type from the event types API.
+func (c *Client) ListAllEventTypes(ctx context.Context) ([]*paddle.EventType, error) {
+	collection, err := c.ListEventTypes(ctx, &paddle.ListEventTypesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTypes []*paddle.EventType
+	err = collection.Iter(ctx, func(eventType *paddle.EventType) (bool, error) {
+		eventTypes = append(eventTypes, eventType)
+		return true, nil
+	})
+	return eventTypes, err
+}
@@ -0,0 +1,72 @@
+package paddleclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Creates a client whose event types API responds with status and body.
+func testEventTypesClient(t *testing.T, status int, body string) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path != "/event-types" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	sdk, err := paddle.New("test", paddle.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(sdk, "sandbox"), &calls
+}
+
+func TestKnownEventTypes_live(t *testing.T) {
+	client, calls := testEventTypesClient(t, http.StatusOK, `{
+		"data": [
+			{"name": "subscription.created", "description": "A subscription was created.", "group": "Subscription", "available_versions": [1]},
+			{"name": "subscription.renewed", "description": "A subscription was renewed.", "group": "Subscription", "available_versions": [1, 2]}
+		],
+		"meta": {"request_id": "req_1"}
+	}`)
+
+	for range 2 {
+		eventTypes := client.KnownEventTypes(context.Background())
+		if len(eventTypes) != 2 || eventTypes[1].Name != "subscription.renewed" {
+			t.Fatalf("expected the event types from the API, got %+v", eventTypes)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call to the event types API, got %d", calls.Load())
+	}
+}
+
+func TestKnownEventTypes_fallback(t *testing.T) {
+	client, calls := testEventTypesClient(t, http.StatusInternalServerError, `{
+		"error": {"type": "api_error", "code": "internal_error", "detail": "boom"},
+		"meta": {"request_id": "req_1"}
+	}`)
+
+	for range 2 {
+		eventTypes := client.KnownEventTypes(context.Background())
+		if len(eventTypes) != len(eventtypes.Embedded()) {
+			t.Fatalf("expected the embedded event types, got %d event types", len(eventTypes))
+		}
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected the event types API to be called again after a failure, got %d calls", calls.Load())
+	}
+}
@@ -3,24 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 
 	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/HQarroum/terraform-provider-paddle/internal/datasources"
+	"github.com/HQarroum/terraform-provider-paddle/internal/ephemeralresources"
+	"github.com/HQarroum/terraform-provider-paddle/internal/functions"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/HQarroum/terraform-provider-paddle/internal/transport"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &paddleProvider{}
+	_ provider.Provider                       = &paddleProvider{}
+	_ provider.ProviderWithListResources      = &paddleProvider{}
+	_ provider.ProviderWithFunctions          = &paddleProvider{}
+	_ provider.ProviderWithEphemeralResources = &paddleProvider{}
 )
 
 // New creates a new instance of the Paddle provider with the specified version.
@@ -41,6 +53,13 @@ type paddleProvider struct {
 type paddleProviderModel struct {
 	ApiKey      types.String `tfsdk:"api_key"`
 	Environment types.String `tfsdk:"environment"`
+	RateLimit   types.Object `tfsdk:"rate_limit"`
+}
+
+// rateLimitModel describes the rate_limit attribute of the provider.
+type rateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
 }
 
 // Metadata returns the provider type name and version.
@@ -63,6 +82,20 @@ func (p *paddleProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Description: "Paddle environment: 'sandbox' or 'production'. Defaults to 'sandbox'. May also be provided via PADDLE_ENVIRONMENT environment variable.",
 				Optional:    true,
 			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Description: "Client-side rate limit shared by every resource and data source. The rate is lowered automatically when Paddle returns rate limit responses.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Description: fmt.Sprintf("Average number of Paddle API calls per second. Defaults to %g.", transport.DefaultRequestsPerSecond),
+						Optional:    true,
+					},
+					"burst": schema.Int64Attribute{
+						Description: fmt.Sprintf("Number of Paddle API calls that can be made at once before the rate applies. Defaults to %d.", transport.DefaultBurst),
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -143,6 +176,40 @@ func (p *paddleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	requestsPerSecond := transport.DefaultRequestsPerSecond
+	burst := transport.DefaultBurst
+
+	if !config.RateLimit.IsNull() && !config.RateLimit.IsUnknown() {
+		var rateLimit rateLimitModel
+		resp.Diagnostics.Append(config.RateLimit.As(ctx, &rateLimit, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !rateLimit.RequestsPerSecond.IsNull() && !rateLimit.RequestsPerSecond.IsUnknown() {
+			requestsPerSecond = rateLimit.RequestsPerSecond.ValueFloat64()
+		}
+		if !rateLimit.Burst.IsNull() && !rateLimit.Burst.IsUnknown() {
+			burst = int(rateLimit.Burst.ValueInt64())
+		}
+	}
+
+	if requestsPerSecond <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit").AtName("requests_per_second"),
+			"Invalid Paddle Rate Limit",
+			fmt.Sprintf("The number of requests per second must be greater than 0, got: %g", requestsPerSecond),
+		)
+	}
+
+	if burst < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit").AtName("burst"),
+			"Invalid Paddle Rate Limit",
+			fmt.Sprintf("The burst must be at least 1, got: %d", burst),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -154,10 +221,29 @@ func (p *paddleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	var client *paddle.SDK
 	var err error
 
+	if err := transport.ConfigureTracing(ctx); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Configure Tracing",
+			"Spans of Paddle API calls will not be exported: "+err.Error(),
+		)
+	}
+
+	// Each call waits for the shared rate limiter and is logged and traced, and
+	// errors carry the Paddle request ID.
+	rateLimiter := transport.NewRateLimiter(requestsPerSecond, burst)
+	httpClient := &http.Client{
+		Transport: transport.WithRequestID(
+			transport.WithRateLimit(
+				transport.WithLogging(http.DefaultTransport),
+				rateLimiter,
+			),
+		),
+	}
+
 	if environment == "sandbox" {
-		client, err = paddle.NewSandbox(apiKey)
+		client, err = paddle.NewSandbox(apiKey, paddle.WithClient(httpClient))
 	} else {
-		client, err = paddle.New(apiKey)
+		client, err = paddle.New(apiKey, paddle.WithClient(httpClient))
 	}
 
 	if err != nil {
@@ -169,10 +255,13 @@ func (p *paddleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Make the Paddle client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// Make the Paddle client available during DataSource, Resource and
+	// ListResource type Configure methods.
+	providerClient := paddleclient.New(client, environment)
+	resp.DataSourceData = providerClient
+	resp.ResourceData = providerClient
+	resp.ListResourceData = providerClient
+	resp.EphemeralResourceData = providerClient
 
 	tflog.Info(ctx, "Configured Paddle client", map[string]any{"success": true})
 }
@@ -184,6 +273,17 @@ func (p *paddleProvider) DataSources(_ context.Context) []func() datasource.Data
 		datasources.NewPriceDataSource,
 		datasources.NewDiscountDataSource,
 		datasources.NewCustomerDataSource,
+		datasources.NewIPAddressesDataSource,
+		datasources.NewEventTypesDataSource,
+		datasources.NewNotificationsDataSource,
+		datasources.NewNotificationSettingHealthDataSource,
+		datasources.NewSimulationRunsDataSource,
+		datasources.NewClientSideTokenDataSource,
+		datasources.NewSubscriptionDataSource,
+		datasources.NewSubscriptionsDataSource,
+		datasources.NewTransactionDataSource,
+		datasources.NewTransactionsDataSource,
+		datasources.NewPricingPreviewDataSource,
 	}
 }
 
@@ -195,5 +295,39 @@ func (p *paddleProvider) Resources(_ context.Context) []func() resource.Resource
 		resources.NewNotificationSettingResource,
 		resources.NewDiscountResource,
 		resources.NewCustomerResource,
+		resources.NewNotificationReplayResource,
+		resources.NewSimulationResource,
+		resources.NewClientSideTokenResource,
+		resources.NewTransactionResource,
+	}
+}
+
+// ListResources returns the list of list resources supported by this provider,
+// used by `terraform query` to find existing objects.
+func (p *paddleProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewProductListResource,
+		resources.NewPriceListResource,
+		resources.NewNotificationSettingListResource,
+		resources.NewDiscountListResource,
+		resources.NewCustomerListResource,
+	}
+}
+
+// EphemeralResources returns the list of ephemeral resources supported by this
+// provider. Their short-lived credentials are never stored in plan or state.
+func (p *paddleProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewCustomerPortalSessionEphemeralResource,
+		ephemeralresources.NewCustomerAuthTokenEphemeralResource,
+	}
+}
+
+// Functions returns the list of functions supported by this provider. They do
+// not call Paddle API, and work without provider configuration.
+func (p *paddleProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewWebhookSignatureFunction,
+		functions.NewVerifyWebhookSignatureFunction,
 	}
 }
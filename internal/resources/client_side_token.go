@@ -0,0 +1,296 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ClientSideTokenResource{}
+var _ resource.ResourceWithImportState = &ClientSideTokenResource{}
+var _ resource.ResourceWithIdentity = &ClientSideTokenResource{}
+
+// Creates a new Paddle client-side token resource.
+func NewClientSideTokenResource() resource.Resource {
+	return &ClientSideTokenResource{}
+}
+
+// A Paddle client-side token, used to authenticate Paddle.js in frontends.
+type ClientSideTokenResource struct {
+	client *paddleclient.Client
+}
+
+type clientSideTokenResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Token       types.String   `tfsdk:"token"`
+	Status      types.String   `tfsdk:"status"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ClientSideTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_side_token"
+}
+
+// Schema defines the schema for the client-side token resource.
+func (r *ClientSideTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Paddle client-side token resource. Client-side tokens authenticate Paddle.js in frontends, and can only open checkouts and preview prices. " +
+			"Paddle cannot change the name or description of a token, so changing them creates a new token. The token is revoked when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle client-side token ID (format: ctkn_...). Not used to authenticate Paddle.js, use `token` instead.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Short name of this client-side token, such as the frontend it is used by.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Short description of where this client-side token is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Client-side token to pass as `token` when initializing Paddle.js, prefixed with `test_` in sandbox and `live_` in production. Client-side tokens are meant to be public, so it is not marked sensitive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of this client-side token. Always `active`, revoked tokens are removed from state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the client-side token was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of a client-side token.
+func (r *ClientSideTokenResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle client-side token ID (format: ctkn_...)")
+}
+
+// Configure initializes the resource with the Paddle SDK client.
+func (r *ClientSideTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Paddle client-side token.
+func (r *ClientSideTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data clientSideTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "client-side token", "create", createTimeout)
+	defer done()
+
+	createReq := &paddle.CreateClientTokenRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+
+	// Create client-side token via Paddle API
+	token, err := r.client.CreateClientToken(ctx, createReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
+			"Error creating client-side token",
+			fmt.Sprintf("Could not create client-side token '%s'", data.Name.ValueString()),
+			err,
+		)
+		return
+	}
+	clientSideTokenToModel(token, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Read retrieves the current state of a Paddle client-side token.
+func (r *ClientSideTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data clientSideTokenResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "client-side token", "read", readTimeout)
+	defer done()
+
+	// Read client-side token from Paddle API
+	token, err := r.client.GetClientToken(ctx, &paddle.GetClientTokenRequest{
+		ClientTokenID: data.ID.ValueString(),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error reading client-side token",
+			fmt.Sprintf("Could not read client-side token ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Revoked tokens cannot be reactivated, so they are deleted as far as
+	// Terraform is concerned and a new token is created
+	if token.Status == paddle.ClientTokenStatusRevoked {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	clientSideTokenToModel(token, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Update only stores new timeouts, as every other attribute requires replacement.
+func (r *ClientSideTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data clientSideTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Delete revokes a Paddle client-side token. Client-side tokens cannot be
+// deleted in Paddle, and Paddle.js stops accepting them once revoked.
+func (r *ClientSideTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data clientSideTokenResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "client-side token", "delete", deleteTimeout)
+	defer done()
+
+	// Revoke the client-side token by setting its status to "revoked"
+	_, err := r.client.UpdateClientToken(ctx, &paddle.UpdateClientTokenRequest{
+		ClientTokenID: data.ID.ValueString(),
+		Status:        paddle.NewPatchField(paddle.ClientTokenStatusRevoked),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error revoking client-side token",
+			fmt.Sprintf("Could not revoke client-side token ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// Imports an existing Paddle client-side token by its ID.
+func (r *ClientSideTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// Copies the attributes of a Paddle client-side token into the resource model.
+func clientSideTokenToModel(token *paddle.ClientSideToken, data *clientSideTokenResourceModel) {
+	data.ID = types.StringValue(token.ID)
+	data.Name = types.StringValue(token.Name)
+	data.Description = types.StringPointerValue(token.Description)
+	data.Token = types.StringValue(token.Token)
+	data.Status = types.StringValue(string(token.Status))
+	data.CreatedAt = types.StringValue(token.CreatedAt)
+}
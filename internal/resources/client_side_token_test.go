@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClientSideTokenResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClientSideTokenResourceConfig("Test frontend"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_client_side_token.test", "name", "Test frontend"),
+					resource.TestCheckResourceAttr("paddle_client_side_token.test", "status", "active"),
+					resource.TestCheckResourceAttrSet("paddle_client_side_token.test", "id"),
+					resource.TestCheckResourceAttrSet("paddle_client_side_token.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "paddle_client_side_token.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Renaming replaces the token
+			{
+				Config: testAccClientSideTokenResourceConfig("Renamed frontend"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_client_side_token.test", "name", "Renamed frontend"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientSideTokenResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "paddle_client_side_token" "test" {
+  name        = %q
+  description = "Paddle.js checkout"
+}
+`, name)
+}
+
+// Returns a client-side token resource backed by a fake Paddle API that answers
+// with a token of the given status, and records the request bodies it receives.
+func testClientSideTokenResource(t *testing.T, status string, requests *[]string) fwresource.Resource {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"data":{"id":"ctkn_01","token":"test_abc","name":"Checkout","status":%q,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"},"meta":{"request_id":"req_01"}}`, status)
+	return testConfigureResource(t, resources.NewClientSideTokenResource(), "sandbox", testRecordingHandler(requests, body))
+}
+
+func TestClientSideTokenResource_Read_revoked(t *testing.T) {
+	ctx := context.Background()
+
+	for status, removed := range map[string]bool{"active": false, "revoked": true} {
+		t.Run(status, func(t *testing.T) {
+			var requests []string
+			r := testClientSideTokenResource(t, status, &requests)
+			resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "ctkn_01"),
+			})
+
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+			identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+
+			resp := fwresource.ReadResponse{
+				State:    tfsdk.State{Schema: resourceSchema, Raw: raw.Copy()},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+			}
+			r.Read(ctx, fwresource.ReadRequest{State: tfsdk.State{Schema: resourceSchema, Raw: raw}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != removed {
+				t.Errorf("expected removed=%t, got state %v", removed, resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestClientSideTokenResource_Delete(t *testing.T) {
+	var requests []string
+	r := testClientSideTokenResource(t, "revoked", &requests)
+	resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "ctkn_01"),
+	})
+
+	var resp fwresource.DeleteResponse
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: tfsdk.State{Schema: resourceSchema, Raw: raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "PATCH /client-tokens/ctkn_01 ") || !strings.Contains(requests[0], `"status":"revoked"`) {
+		t.Errorf("expected the token to be revoked, got %v", requests)
+	}
+}
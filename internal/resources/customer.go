@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +21,8 @@ import (
 
 var _ resource.Resource = &CustomerResource{}
 var _ resource.ResourceWithImportState = &CustomerResource{}
+var _ resource.ResourceWithUpgradeState = &CustomerResource{}
+var _ resource.ResourceWithIdentity = &CustomerResource{}
 
 // Creates a new Paddle customer resource.
 func NewCustomerResource() resource.Resource {
@@ -24,19 +30,20 @@ func NewCustomerResource() resource.Resource {
 }
 
 type CustomerResource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type customerResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Email            types.String `tfsdk:"email"`
-	MarketingConsent types.Bool   `tfsdk:"marketing_consent"`
-	Status           types.String `tfsdk:"status"`
-	CustomData       types.Map    `tfsdk:"custom_data"`
-	Locale           types.String `tfsdk:"locale"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ID               types.String       `tfsdk:"id"`
+	Name             types.String       `tfsdk:"name"`
+	Email            types.String       `tfsdk:"email"`
+	MarketingConsent types.Bool         `tfsdk:"marketing_consent"`
+	Status           types.String       `tfsdk:"status"`
+	CustomData       types.Map          `tfsdk:"custom_data"`
+	Locale           customtypes.Locale `tfsdk:"locale"`
+	CreatedAt        types.String       `tfsdk:"created_at"`
+	UpdatedAt        types.String       `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value     `tfsdk:"timeouts"`
 }
 
 func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +52,7 @@ func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Paddle customer resource. Customers represent people and businesses that make purchases.",
 
 		Attributes: map[string]schema.Attribute{
@@ -85,6 +93,7 @@ func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaReques
 			"locale": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
+				CustomType:          customtypes.LocaleType{},
 				MarkdownDescription: "Valid IETF BCP 47 short form locale tag (e.g., 'en', 'en-US'). Defaults to 'en'.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -102,19 +111,31 @@ func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "RFC 3339 timestamp when the customer was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *CustomerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle customer ID (format: ctm_...)")
+}
+
 func (r *CustomerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -130,6 +151,14 @@ func (r *CustomerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "customer", "create", createTimeout)
+	defer done()
+
 	createReq := &paddle.CreateCustomerRequest{
 		Email: data.Email.ValueString(),
 	}
@@ -155,9 +184,12 @@ func (r *CustomerResource) Create(ctx context.Context, req resource.CreateReques
 
 	customer, err := r.client.CreateCustomer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error creating customer",
-			fmt.Sprintf("Could not create customer with email '%s': %s", data.Email.ValueString(), err.Error()),
+			fmt.Sprintf("Could not create customer with email '%s'", data.Email.ValueString()),
+			err,
 		)
 		return
 	}
@@ -169,10 +201,11 @@ func (r *CustomerResource) Create(ctx context.Context, req resource.CreateReques
 	data.UpdatedAt = types.StringValue(customer.UpdatedAt)
 
 	if customer.Locale != "" {
-		data.Locale = types.StringValue(customer.Locale)
+		data.Locale = customtypes.NewLocaleValue(customer.Locale)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -183,50 +216,40 @@ func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "customer", "read", readTimeout)
+	defer done()
+
 	customer, err := r.client.GetCustomer(ctx, &paddle.GetCustomerRequest{
 		CustomerID: data.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error reading customer",
-			fmt.Sprintf("Could not read customer ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read customer ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 
-	data.Email = types.StringValue(customer.Email)
-	data.MarketingConsent = types.BoolValue(customer.MarketingConsent)
-	data.Status = types.StringValue(string(customer.Status))
-	data.Locale = types.StringValue(customer.Locale)
-	data.CreatedAt = types.StringValue(customer.CreatedAt)
-	data.UpdatedAt = types.StringValue(customer.UpdatedAt)
-
-	if customer.Name != nil {
-		data.Name = types.StringValue(*customer.Name)
-	} else {
-		data.Name = types.StringNull()
-	}
-
-	if customer.CustomData != nil {
-		customDataMap, err := helpers.CustomDataToMap(customer.CustomData)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error processing custom data",
-				fmt.Sprintf("Could not convert custom_data for customer %s: %s", data.ID.ValueString(), err.Error()),
-			)
-			return
-		}
-		customDataValue, diags := types.MapValueFrom(ctx, types.StringType, customDataMap)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CustomData = customDataValue
-	} else {
-		data.CustomData = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(customerToModel(ctx, customer, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -237,6 +260,19 @@ func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "customer", "update", updateTimeout)
+	defer done()
+
 	updateReq := &paddle.UpdateCustomerRequest{
 		CustomerID: data.ID.ValueString(),
 		Email:      paddle.NewPatchField(data.Email.ValueString()),
@@ -267,9 +303,12 @@ func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateReques
 
 	customer, err := r.client.UpdateCustomer(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error updating customer",
-			fmt.Sprintf("Could not update customer ID %s (email: %s): %s", data.ID.ValueString(), data.Email.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update customer ID %s (email: %s)", data.ID.ValueString(), data.Email.ValueString()),
+			err,
 		)
 		return
 	}
@@ -279,6 +318,7 @@ func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateReques
 	data.UpdatedAt = types.StringValue(customer.UpdatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,6 +329,19 @@ func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "customer", "delete", deleteTimeout)
+	defer done()
+
 	updateReq := &paddle.UpdateCustomerRequest{
 		CustomerID: data.ID.ValueString(),
 		Status:     paddle.NewPatchField(paddle.StatusArchived),
@@ -296,14 +349,73 @@ func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.client.UpdateCustomer(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error archiving customer",
-			fmt.Sprintf("Could not archive customer ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not archive customer ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 }
 
+// Copies the attributes of a Paddle customer into the resource model.
+func customerToModel(ctx context.Context, customer *paddle.Customer, data *customerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(customer.ID)
+	data.Email = types.StringValue(customer.Email)
+	data.MarketingConsent = types.BoolValue(customer.MarketingConsent)
+	data.Status = types.StringValue(string(customer.Status))
+	data.Locale = customtypes.NewLocaleValue(customer.Locale)
+	data.CreatedAt = types.StringValue(customer.CreatedAt)
+	data.UpdatedAt = types.StringValue(customer.UpdatedAt)
+
+	if customer.Name != nil {
+		data.Name = types.StringValue(*customer.Name)
+	} else {
+		data.Name = types.StringNull()
+	}
+
+	if customer.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(customer.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for customer %s: %s", customer.ID, err.Error()),
+			)
+			return diags
+		}
+		customDataValue, d := types.MapValueFrom(ctx, types.StringType, customDataMap)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CustomData = customDataValue
+	} else {
+		data.CustomData = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
+
 func (r *CustomerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// State migrations, indexed by the schema version they upgrade from.
+var customerStateMigrations = []stateMigration{
+	// 0 -> 1: locale became a case-insensitive locale type.
+	unchangedState,
+}
+
+// UpgradeState migrates state from earlier schema versions.
+func (r *CustomerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(ctx, r, customerStateMigrations)
 }
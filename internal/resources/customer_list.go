@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &CustomerListResource{}
+var _ list.ListResourceWithConfigure = &CustomerListResource{}
+
+// Creates a new Paddle customer list resource.
+func NewCustomerListResource() list.ListResource {
+	return &CustomerListResource{}
+}
+
+// Customer list resource finds Paddle customers for `terraform query`.
+type CustomerListResource struct {
+	client *paddleclient.Client
+}
+
+type customerListModel struct {
+	ID      types.List   `tfsdk:"id"`
+	Email   types.List   `tfsdk:"email"`
+	Status  types.List   `tfsdk:"status"`
+	Search  types.String `tfsdk:"search"`
+	OrderBy types.String `tfsdk:"order_by"`
+}
+
+func (r *CustomerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (r *CustomerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Paddle customers, using the filters of the list customers endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only the customers with these IDs.",
+			},
+			"email": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only customers with exactly these email addresses.",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only customers with these statuses: 'active' or 'archived'.",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only customers whose ID, name or email matches this query.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Order customers by a field and direction, for example `id[DESC]`.",
+			},
+		},
+	}
+}
+
+func (r *CustomerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *CustomerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config customerListModel
+
+	diags := req.Config.Get(ctx, &config)
+	listReq := &paddle.ListCustomersRequest{
+		ID:      stringsFilter(ctx, config.ID, &diags),
+		Email:   stringsFilter(ctx, config.Email, &diags),
+		Status:  stringsFilter(ctx, config.Status, &diags),
+		Search:  stringFilter(config.Search),
+		OrderBy: stringFilter(config.OrderBy),
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, "customers",
+		func(ctx context.Context, perPage int) (*paddle.Collection[*paddle.Customer], error) {
+			listReq.PerPage = &perPage
+			return r.client.ListCustomers(ctx, listReq)
+		},
+		func(customer *paddle.Customer) (string, string) {
+			return customer.ID, customer.Email
+		},
+		customerToModel,
+	)
+}
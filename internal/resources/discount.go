@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
 	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +24,8 @@ import (
 
 var _ resource.Resource = &DiscountResource{}
 var _ resource.ResourceWithImportState = &DiscountResource{}
+var _ resource.ResourceWithUpgradeState = &DiscountResource{}
+var _ resource.ResourceWithIdentity = &DiscountResource{}
 
 // Creates a new Paddle discount resource.
 func NewDiscountResource() resource.Resource {
@@ -27,29 +33,30 @@ func NewDiscountResource() resource.Resource {
 }
 
 type DiscountResource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 type discountResourceModel struct {
-	ID                        types.String `tfsdk:"id"`
-	Status                    types.String `tfsdk:"status"`
-	Description               types.String `tfsdk:"description"`
-	EnabledForCheckout        types.Bool   `tfsdk:"enabled_for_checkout"`
-	Code                      types.String `tfsdk:"code"`
-	Type                      types.String `tfsdk:"type"`
-	Mode                      types.String `tfsdk:"mode"`
-	Amount                    types.String `tfsdk:"amount"`
-	CurrencyCode              types.String `tfsdk:"currency_code"`
-	Recur                     types.Bool   `tfsdk:"recur"`
-	MaximumRecurringIntervals types.Int64  `tfsdk:"maximum_recurring_intervals"`
-	UsageLimit                types.Int64  `tfsdk:"usage_limit"`
-	RestrictTo                types.List   `tfsdk:"restrict_to"`
-	ExpiresAt                 types.String `tfsdk:"expires_at"`
-	CustomData                types.Map    `tfsdk:"custom_data"`
-	TimesUsed                 types.Int64  `tfsdk:"times_used"`
-	DiscountGroupID           types.String `tfsdk:"discount_group_id"`
-	CreatedAt                 types.String `tfsdk:"created_at"`
-	UpdatedAt                 types.String `tfsdk:"updated_at"`
+	ID                        types.String              `tfsdk:"id"`
+	Status                    types.String              `tfsdk:"status"`
+	Description               types.String              `tfsdk:"description"`
+	EnabledForCheckout        types.Bool                `tfsdk:"enabled_for_checkout"`
+	Code                      types.String              `tfsdk:"code"`
+	Type                      types.String              `tfsdk:"type"`
+	Mode                      types.String              `tfsdk:"mode"`
+	Amount                    customtypes.MoneyAmount   `tfsdk:"amount"`
+	CurrencyCode              types.String              `tfsdk:"currency_code"`
+	Recur                     types.Bool                `tfsdk:"recur"`
+	MaximumRecurringIntervals types.Int64               `tfsdk:"maximum_recurring_intervals"`
+	UsageLimit                types.Int64               `tfsdk:"usage_limit"`
+	RestrictTo                customtypes.UnorderedList `tfsdk:"restrict_to"`
+	ExpiresAt                 customtypes.RFC3339       `tfsdk:"expires_at"`
+	CustomData                types.Map                 `tfsdk:"custom_data"`
+	TimesUsed                 types.Int64               `tfsdk:"times_used"`
+	DiscountGroupID           types.String              `tfsdk:"discount_group_id"`
+	CreatedAt                 types.String              `tfsdk:"created_at"`
+	UpdatedAt                 types.String              `tfsdk:"updated_at"`
+	Timeouts                  timeouts.Value            `tfsdk:"timeouts"`
 }
 
 func (r *DiscountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +65,7 @@ func (r *DiscountResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *DiscountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Paddle discount resource. Discounts reduce transaction totals by percentage or amount. Sometimes called coupons or promo codes.",
 
 		Attributes: map[string]schema.Attribute{
@@ -106,6 +114,7 @@ func (r *DiscountResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"amount": schema.StringAttribute{
 				Required:            true,
+				CustomType:          customtypes.MoneyAmountType{},
 				MarkdownDescription: "Amount to discount by. For `percentage`: value between 0.01 and 100. For `flat`/`flat_per_seat`: amount in lowest denomination (e.g., cents).",
 			},
 			"currency_code": schema.StringAttribute{
@@ -137,10 +146,12 @@ func (r *DiscountResource) Schema(ctx context.Context, req resource.SchemaReques
 			"restrict_to": schema.ListAttribute{
 				Optional:            true,
 				ElementType:         types.StringType,
+				CustomType:          customtypes.NewUnorderedListType(types.StringType),
 				MarkdownDescription: "Product or price IDs this discount applies to (e.g., ['pro_...', 'pri_...']). Omit to apply to all products and prices.",
 			},
 			"expires_at": schema.StringAttribute{
 				Optional:            true,
+				CustomType:          customtypes.RFC3339Type{},
 				MarkdownDescription: "RFC 3339 datetime when this discount expires. Omit for discount that never expires.",
 			},
 			"custom_data": schema.MapAttribute{
@@ -171,19 +182,31 @@ func (r *DiscountResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "RFC 3339 timestamp when the discount was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *DiscountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle discount ID (format: dsc_...)")
+}
+
 func (r *DiscountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -199,6 +222,14 @@ func (r *DiscountResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "discount", "create", createTimeout)
+	defer done()
+
 	createReq := &paddle.CreateDiscountRequest{
 		Description: data.Description.ValueString(),
 		Type:        paddle.DiscountType(data.Type.ValueString()),
@@ -270,9 +301,12 @@ func (r *DiscountResource) Create(ctx context.Context, req resource.CreateReques
 
 	discount, err := r.client.CreateDiscount(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error creating discount",
-			fmt.Sprintf("Could not create discount with code '%s' (type: %s): %s", data.Code.ValueString(), data.Type.ValueString(), err.Error()),
+			fmt.Sprintf("Could not create discount with code '%s' (type: %s)", data.Code.ValueString(), data.Type.ValueString()),
+			err,
 		)
 		return
 	}
@@ -286,6 +320,7 @@ func (r *DiscountResource) Create(ctx context.Context, req resource.CreateReques
 	data.UpdatedAt = types.StringValue(discount.UpdatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *DiscountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -296,95 +331,40 @@ func (r *DiscountResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "discount", "read", readTimeout)
+	defer done()
+
 	discount, err := r.client.GetDiscount(ctx, &paddle.GetDiscountRequest{
 		DiscountID: data.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error reading discount",
-			fmt.Sprintf("Could not read discount ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read discount ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 
-	data.Status = types.StringValue(string(discount.Status))
-	data.Description = types.StringValue(discount.Description)
-	data.EnabledForCheckout = types.BoolValue(discount.EnabledForCheckout)
-	data.Type = types.StringValue(string(discount.Type))
-	data.Mode = types.StringValue(string(discount.Mode))
-	data.Amount = types.StringValue(discount.Amount)
-	data.Recur = types.BoolValue(discount.Recur)
-	data.TimesUsed = types.Int64Value(int64(discount.TimesUsed))
-	data.CreatedAt = types.StringValue(discount.CreatedAt)
-	data.UpdatedAt = types.StringValue(discount.UpdatedAt)
-
-	if discount.Code != nil {
-		data.Code = types.StringValue(*discount.Code)
-	} else {
-		data.Code = types.StringNull()
-	}
-
-	if discount.CurrencyCode != nil {
-		data.CurrencyCode = types.StringValue(string(*discount.CurrencyCode))
-	} else {
-		data.CurrencyCode = types.StringNull()
-	}
-
-	if discount.MaximumRecurringIntervals != nil {
-		data.MaximumRecurringIntervals = types.Int64Value(int64(*discount.MaximumRecurringIntervals))
-	} else {
-		data.MaximumRecurringIntervals = types.Int64Null()
-	}
-
-	if discount.UsageLimit != nil {
-		data.UsageLimit = types.Int64Value(int64(*discount.UsageLimit))
-	} else {
-		data.UsageLimit = types.Int64Null()
-	}
-
-	if len(discount.RestrictTo) > 0 {
-		restrictToList, diags := types.ListValueFrom(ctx, types.StringType, discount.RestrictTo)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.RestrictTo = restrictToList
-	} else {
-		data.RestrictTo = types.ListNull(types.StringType)
-	}
-
-	if discount.ExpiresAt != nil {
-		data.ExpiresAt = types.StringValue(*discount.ExpiresAt)
-	} else {
-		data.ExpiresAt = types.StringNull()
-	}
-
-	if discount.CustomData != nil {
-		customDataMap, err := helpers.CustomDataToMap(discount.CustomData)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error processing custom data",
-				fmt.Sprintf("Could not convert custom_data for discount %s: %s", data.ID.ValueString(), err.Error()),
-			)
-			return
-		}
-		customDataValue, diags := types.MapValueFrom(ctx, types.StringType, customDataMap)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CustomData = customDataValue
-	} else {
-		data.CustomData = types.MapNull(types.StringType)
-	}
-
-	if discount.DiscountGroupID != nil {
-		data.DiscountGroupID = types.StringValue(*discount.DiscountGroupID)
-	} else {
-		data.DiscountGroupID = types.StringNull()
+	resp.Diagnostics.Append(discountToModel(ctx, discount, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *DiscountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -395,6 +375,19 @@ func (r *DiscountResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "discount", "update", updateTimeout)
+	defer done()
+
 	updateReq := &paddle.UpdateDiscountRequest{
 		DiscountID:  data.ID.ValueString(),
 		Description: paddle.NewPatchField(data.Description.ValueString()),
@@ -463,9 +456,12 @@ func (r *DiscountResource) Update(ctx context.Context, req resource.UpdateReques
 
 	discount, err := r.client.UpdateDiscount(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error updating discount",
-			fmt.Sprintf("Could not update discount ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update discount ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
@@ -477,6 +473,7 @@ func (r *DiscountResource) Update(ctx context.Context, req resource.UpdateReques
 	data.UpdatedAt = types.StringValue(discount.UpdatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 func (r *DiscountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -487,6 +484,19 @@ func (r *DiscountResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "discount", "delete", deleteTimeout)
+	defer done()
+
 	updateReq := &paddle.UpdateDiscountRequest{
 		DiscountID: data.ID.ValueString(),
 		Status:     paddle.NewPatchField(paddle.DiscountStatusArchived),
@@ -494,14 +504,118 @@ func (r *DiscountResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	_, err := r.client.UpdateDiscount(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error archiving discount",
-			fmt.Sprintf("Could not archive discount ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not archive discount ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 }
 
+// Copies the attributes of a Paddle discount into the resource model.
+func discountToModel(ctx context.Context, discount *paddle.Discount, data *discountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(discount.ID)
+	data.Status = types.StringValue(string(discount.Status))
+	data.Description = types.StringValue(discount.Description)
+	data.EnabledForCheckout = types.BoolValue(discount.EnabledForCheckout)
+	data.Type = types.StringValue(string(discount.Type))
+	data.Mode = types.StringValue(string(discount.Mode))
+	data.Amount = customtypes.NewMoneyAmountValue(discount.Amount)
+	data.Recur = types.BoolValue(discount.Recur)
+	data.TimesUsed = types.Int64Value(int64(discount.TimesUsed))
+	data.CreatedAt = types.StringValue(discount.CreatedAt)
+	data.UpdatedAt = types.StringValue(discount.UpdatedAt)
+
+	if discount.Code != nil {
+		data.Code = types.StringValue(*discount.Code)
+	} else {
+		data.Code = types.StringNull()
+	}
+
+	if discount.CurrencyCode != nil {
+		data.CurrencyCode = types.StringValue(string(*discount.CurrencyCode))
+	} else {
+		data.CurrencyCode = types.StringNull()
+	}
+
+	if discount.MaximumRecurringIntervals != nil {
+		data.MaximumRecurringIntervals = types.Int64Value(int64(*discount.MaximumRecurringIntervals))
+	} else {
+		data.MaximumRecurringIntervals = types.Int64Null()
+	}
+
+	if discount.UsageLimit != nil {
+		data.UsageLimit = types.Int64Value(int64(*discount.UsageLimit))
+	} else {
+		data.UsageLimit = types.Int64Null()
+	}
+
+	if len(discount.RestrictTo) > 0 {
+		restrictToList, d := customtypes.NewUnorderedListValueFrom(ctx, types.StringType, discount.RestrictTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.RestrictTo = restrictToList
+	} else {
+		data.RestrictTo = customtypes.NewUnorderedListNull(types.StringType)
+	}
+
+	if discount.ExpiresAt != nil {
+		data.ExpiresAt = customtypes.NewRFC3339Value(*discount.ExpiresAt)
+	} else {
+		data.ExpiresAt = customtypes.NewRFC3339Null()
+	}
+
+	if discount.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(discount.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for discount %s: %s", discount.ID, err.Error()),
+			)
+			return diags
+		}
+		customDataValue, d := types.MapValueFrom(ctx, types.StringType, customDataMap)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CustomData = customDataValue
+	} else {
+		data.CustomData = types.MapNull(types.StringType)
+	}
+
+	if discount.DiscountGroupID != nil {
+		data.DiscountGroupID = types.StringValue(*discount.DiscountGroupID)
+	} else {
+		data.DiscountGroupID = types.StringNull()
+	}
+
+	return diags
+}
+
 func (r *DiscountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// State migrations, indexed by the schema version they upgrade from.
+var discountStateMigrations = []stateMigration{
+	// 0 -> 1: amount, restrict_to and expires_at gained semantic-equality types.
+	unchangedState,
+}
+
+// UpgradeState migrates state from earlier schema versions.
+func (r *DiscountResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(ctx, r, discountStateMigrations)
 }
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &DiscountListResource{}
+var _ list.ListResourceWithConfigure = &DiscountListResource{}
+
+// Creates a new Paddle discount list resource.
+func NewDiscountListResource() list.ListResource {
+	return &DiscountListResource{}
+}
+
+// Discount list resource finds Paddle discounts for `terraform query`.
+type DiscountListResource struct {
+	client *paddleclient.Client
+}
+
+type discountListModel struct {
+	ID              types.List   `tfsdk:"id"`
+	Code            types.List   `tfsdk:"code"`
+	Status          types.List   `tfsdk:"status"`
+	Mode            types.String `tfsdk:"mode"`
+	DiscountGroupID types.List   `tfsdk:"discount_group_id"`
+	OrderBy         types.String `tfsdk:"order_by"`
+}
+
+func (r *DiscountListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discount"
+}
+
+func (r *DiscountListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Paddle discounts, using the filters of the list discounts endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only the discounts with these IDs.",
+			},
+			"code": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only discounts with these codes.",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only discounts with these statuses: 'active', 'archived', 'expired' or 'used'.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only discounts with this mode: 'standard' or 'custom'.",
+			},
+			"discount_group_id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only discounts in these discount groups.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Order discounts by a field and direction, for example `created_at[DESC]`.",
+			},
+		},
+	}
+}
+
+func (r *DiscountListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *DiscountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config discountListModel
+
+	diags := req.Config.Get(ctx, &config)
+	listReq := &paddle.ListDiscountsRequest{
+		ID:              stringsFilter(ctx, config.ID, &diags),
+		Code:            stringsFilter(ctx, config.Code, &diags),
+		Status:          stringsFilter(ctx, config.Status, &diags),
+		Mode:            stringFilter(config.Mode),
+		DiscountGroupID: stringsFilter(ctx, config.DiscountGroupID, &diags),
+		OrderBy:         stringFilter(config.OrderBy),
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, "discounts",
+		func(ctx context.Context, perPage int) (*paddle.Collection[*paddle.Discount], error) {
+			listReq.PerPage = &perPage
+			return r.client.ListDiscounts(ctx, listReq)
+		},
+		func(discount *paddle.Discount) (string, string) {
+			if discount.Code != nil {
+				return discount.ID, *discount.Code
+			}
+			return discount.ID, discount.Description
+		},
+		discountToModel,
+	)
+}
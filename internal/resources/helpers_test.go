@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Returns a client for environment calling a fake Paddle API served by handler.
+func testPaddleClient(t *testing.T, environment string, handler http.Handler) *paddleclient.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sdk, err := paddle.New("test", paddle.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return paddleclient.New(sdk, environment)
+}
+
+// Configures r with a client for environment calling a fake Paddle API served
+// by handler, and returns it.
+func testConfigureResource(t *testing.T, r fwresource.Resource, environment string, handler http.Handler) fwresource.Resource {
+	t.Helper()
+
+	var configureResp fwresource.ConfigureResponse
+	r.(fwresource.ResourceWithConfigure).Configure(context.Background(), fwresource.ConfigureRequest{ProviderData: testPaddleClient(t, environment, handler)}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+	return r
+}
+
+// Returns a handler answering every request with body, and recording each
+// request as its method, path and body.
+func testRecordingHandler(requests *[]string, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestBody, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, r.Method+" "+r.URL.Path+" "+string(requestBody))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// Returns a resource object whose attributes are null except the given ones.
+func testResourceRaw(t *testing.T, r fwresource.Resource, attributes map[string]tftypes.Value) (schema.Schema, tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	return schemaResp.Schema, tftypes.NewValue(objectType, values)
+}
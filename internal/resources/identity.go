@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Identity shared by every resource: Paddle objects are identified by their ID
+// within the environment they live in.
+type resourceIdentityModel struct {
+	ID          types.String `tfsdk:"id"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+// Returns the identity of the object with the given ID, in the environment the
+// client calls.
+func newResourceIdentity(client *paddleclient.Client, id types.String) resourceIdentityModel {
+	return resourceIdentityModel{
+		ID:          id,
+		Environment: types.StringValue(client.Environment),
+	}
+}
+
+// Returns the identity schema of a resource, describing its Paddle ID.
+func paddleIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       idDescription,
+			},
+			"environment": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Paddle environment the object lives in: 'sandbox' or 'production'. Defaults to the environment of the provider when importing.",
+			},
+		},
+	}
+}
+
+// Adds an error when an identity records another environment than the one the
+// client calls, so that state or import blocks written for sandbox objects are
+// never applied with a production provider, or the other way around.
+func checkIdentityEnvironment(ctx context.Context, identity *tfsdk.ResourceIdentity, client *paddleclient.Client, diags *diag.Diagnostics) {
+	if identity == nil || identity.Raw.IsNull() {
+		return
+	}
+
+	var environment types.String
+	diags.Append(identity.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	if diags.HasError() || environment.IsNull() || environment.IsUnknown() {
+		return
+	}
+
+	if environment.ValueString() != client.Environment {
+		diags.AddError(
+			"Paddle Environment Mismatch",
+			fmt.Sprintf(
+				"This object lives in the Paddle %s environment, but the provider is configured for the %s environment. "+
+					"Configure the provider for the %s environment, or remove the object from this state.",
+				environment.ValueString(), client.Environment, environment.ValueString(),
+			),
+		)
+	}
+}
@@ -0,0 +1,113 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Returns a product resource configured for environment, counting the calls it
+// makes to a fake Paddle API.
+func testProductResource(t *testing.T, environment string, calls *int) resource.Resource {
+	t.Helper()
+
+	return testConfigureResource(t, resources.NewProductResource(), environment, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"id":"pro_01","name":"Basic","tax_category":"saas","status":"active","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"},"meta":{"request_id":"req_01"}}`))
+	}))
+}
+
+// Reads a product whose state holds the given identity environment.
+func readProduct(t *testing.T, r resource.Resource, identityEnvironment tftypes.Value) *resource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	stateType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	stateValues := map[string]tftypes.Value{}
+	for name, attributeType := range stateType.AttributeTypes {
+		stateValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	stateValues["id"] = tftypes.NewValue(tftypes.String, "pro_01")
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(stateType, stateValues)}
+
+	identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "pro_01"),
+			"environment": identityEnvironment,
+		}),
+	}
+
+	resp := &resource.ReadResponse{
+		State:    state,
+		Identity: &tfsdk.ResourceIdentity{Schema: identity.Schema, Raw: identity.Raw.Copy()},
+	}
+	r.Read(ctx, resource.ReadRequest{State: state, Identity: identity}, resp)
+	return resp
+}
+
+func TestProductResource_Read_identityEnvironment(t *testing.T) {
+	tests := []struct {
+		name                string
+		identityEnvironment tftypes.Value
+		expectError         bool
+	}{
+		{
+			name:                "same environment",
+			identityEnvironment: tftypes.NewValue(tftypes.String, "sandbox"),
+		},
+		{
+			name:                "no recorded environment",
+			identityEnvironment: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:                "other environment",
+			identityEnvironment: tftypes.NewValue(tftypes.String, "production"),
+			expectError:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			resp := readProduct(t, testProductResource(t, "sandbox", &calls), tt.identityEnvironment)
+
+			if tt.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an environment mismatch error")
+				}
+				if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Paddle Environment Mismatch" {
+					t.Errorf("unexpected diagnostic summary %q", summary)
+				}
+				if calls != 0 {
+					t.Errorf("expected no Paddle API call but got %d", calls)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var environment types.String
+			resp.Identity.GetAttribute(context.Background(), path.Root("environment"), &environment)
+			if environment.ValueString() != "sandbox" {
+				t.Errorf("expected identity environment 'sandbox' but got %s", environment)
+			}
+		})
+	}
+}
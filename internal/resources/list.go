@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Number of entities requested per page when listing, the Paddle maximum.
+const listPageSize = 200
+
+// Returns the client passed as provider data to a list resource.
+func configureListClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *paddleclient.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+
+	return client
+}
+
+// Streams one list result per entity returned by fetch, until Terraform stops
+// reading or the requested limit is reached. Each result carries the identity and
+// display name of the entity, and its full resource when Terraform asks for it.
+// API errors are pushed as a final result holding only diagnostics.
+func listResults[T, M any](
+	ctx context.Context,
+	req list.ListRequest,
+	client *paddleclient.Client,
+	entityName string,
+	fetch func(ctx context.Context, perPage int) (*paddle.Collection[T], error),
+	describe func(entity T) (id, displayName string),
+	toModel func(ctx context.Context, entity T, data *M) diag.Diagnostics,
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		perPage := listPageSize
+		if req.Limit > 0 && req.Limit < listPageSize {
+			perPage = int(req.Limit)
+		}
+
+		var listErr error
+		collection, err := fetch(ctx, perPage)
+		if err == nil {
+			var count int64
+			listErr = collection.Iter(ctx, func(entity T) (bool, error) {
+				if !push(newListResult(ctx, req, client, entity, describe, toModel)) {
+					return false, nil
+				}
+				count++
+				return req.Limit <= 0 || count < req.Limit, nil
+			})
+		} else {
+			listErr = err
+		}
+
+		if listErr != nil {
+			var diags diag.Diagnostics
+			helpers.AddAPIError(
+				&diags,
+				req.Config.Schema.Type(),
+				fmt.Sprintf("Error listing %s", entityName),
+				fmt.Sprintf("Could not list %s", entityName),
+				listErr,
+			)
+			push(list.ListResult{Diagnostics: diags})
+		}
+	}
+}
+
+// Builds the list result of one entity.
+func newListResult[T, M any](
+	ctx context.Context,
+	req list.ListRequest,
+	client *paddleclient.Client,
+	entity T,
+	describe func(entity T) (id, displayName string),
+	toModel func(ctx context.Context, entity T, data *M) diag.Diagnostics,
+) list.ListResult {
+	result := req.NewListResult(ctx)
+
+	id, displayName := describe(entity)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(result.Identity.Set(ctx, newResourceIdentity(client, types.StringValue(id)))...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Start from a resource whose attributes are all null, so that attributes
+	// the API does not return, such as timeouts, are typed in the model.
+	objectType := req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	result.Resource.Raw = tftypes.NewValue(objectType, attributes)
+
+	var data M
+	result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	result.Diagnostics.Append(toModel(ctx, entity, &data)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+
+	return result
+}
+
+// Returns the values of a list filter, or nil when it is not set.
+func stringsFilter(ctx context.Context, value types.List, diags *diag.Diagnostics) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var values []string
+	diags.Append(value.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// Returns the value of a string filter, or nil when it is not set.
+func stringFilter(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+// Returns the value of a bool filter, or nil when it is not set.
+func boolFilter(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
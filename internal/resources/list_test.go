@@ -0,0 +1,171 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testProductsPage = `{
+	"data": [
+		{"id": "pro_01", "name": "Basic", "tax_category": "saas", "status": "active", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"},
+		{"id": "pro_02", "name": "Pro", "description": "For teams", "tax_category": "saas", "status": "active", "custom_data": {"tier": "2"}, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"},
+		{"id": "pro_03", "name": "Enterprise", "tax_category": "saas", "status": "active", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}
+	],
+	"meta": {"request_id": "req_01", "pagination": {"per_page": 3, "next": "", "has_more": false, "estimated_total": 3}}
+}`
+
+// Runs the product list resource against a fake Paddle API and collects its results.
+func listProducts(t *testing.T, handler http.HandlerFunc, config map[string]tftypes.Value, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	listResource := resources.NewProductListResource().(list.ListResourceWithConfigure)
+	var configureResp resource.ConfigureResponse
+	listResource.Configure(ctx, resource.ConfigureRequest{ProviderData: testPaddleClient(t, "sandbox", handler)}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	var listSchemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+
+	productResource := resources.NewProductResource().(resource.ResourceWithIdentity)
+	var schemaResp resource.SchemaResponse
+	productResource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	productResource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	configType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		configValues[name] = value
+	}
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: listSchemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, configValues),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	var stream list.ListResultsStream
+	listResource.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestProductListResource_List(t *testing.T) {
+	var query url.Values
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testProductsPage))
+	}
+
+	status := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "active"),
+	})
+	results := listProducts(t, handler, map[string]tftypes.Value{"status": status}, true, 0)
+
+	if got := query.Get("status"); got != "active" {
+		t.Errorf("expected status filter 'active' but got %q", got)
+	}
+	if got := query.Get("per_page"); got != "200" {
+		t.Errorf("expected per_page 200 but got %q", got)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results but got %d", len(results))
+	}
+
+	ctx := context.Background()
+	for i, result := range results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+
+		var id, environment types.String
+		result.Identity.GetAttribute(ctx, path.Root("id"), &id)
+		result.Identity.GetAttribute(ctx, path.Root("environment"), &environment)
+		if expected := fmt.Sprintf("pro_0%d", i+1); id.ValueString() != expected || environment.ValueString() != "sandbox" {
+			t.Errorf("expected identity %s in sandbox but got %s in %s", expected, id, environment)
+		}
+	}
+
+	second := results[1]
+	if second.DisplayName != "Pro" {
+		t.Errorf("expected display name 'Pro' but got %q", second.DisplayName)
+	}
+
+	var description, tier types.String
+	second.Resource.GetAttribute(ctx, path.Root("description"), &description)
+	second.Resource.GetAttribute(ctx, path.Root("custom_data").AtMapKey("tier"), &tier)
+	if description.ValueString() != "For teams" || tier.ValueString() != "2" {
+		t.Errorf("expected resource attributes from the API but got description %s and tier %s", description, tier)
+	}
+}
+
+func TestProductListResource_List_limitWithoutResource(t *testing.T) {
+	var query url.Values
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testProductsPage))
+	}
+
+	results := listProducts(t, handler, nil, false, 2)
+
+	if got := query.Get("per_page"); got != "2" {
+		t.Errorf("expected per_page 2 but got %q", got)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(results))
+	}
+	if !results[0].Resource.Raw.IsNull() {
+		t.Errorf("expected no resource when it is not requested but got %s", results[0].Resource.Raw)
+	}
+}
+
+func TestProductListResource_List_apiError(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"type":"request_error","code":"invalid_field","detail":"Invalid request.","errors":[{"field":"status","message":"must be one of active, archived"}]},"meta":{"request_id":"req_01"}}`))
+	}
+
+	status := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "deleted"),
+	})
+	results := listProducts(t, handler, map[string]tftypes.Value{"status": status}, true, 0)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result but got %d", len(results))
+	}
+	diags := results[0].Diagnostics
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	if errs := diags.Errors(); errs[0].Summary() != "Error listing products" {
+		t.Errorf("unexpected diagnostic summary %q", errs[0].Summary())
+	}
+}
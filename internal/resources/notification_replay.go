@@ -0,0 +1,231 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationReplayResource{}
+
+// Creates a new Paddle notification replay resource.
+func NewNotificationReplayResource() resource.Resource {
+	return &NotificationReplayResource{}
+}
+
+// Replays Paddle notifications when created. It does not manage a Paddle object:
+// destroying it only removes it from state.
+type NotificationReplayResource struct {
+	client *paddleclient.Client
+}
+
+// notificationReplayResourceModel describes the resource data model.
+type notificationReplayResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	NotificationIDs       types.List     `tfsdk:"notification_ids"`
+	Triggers              types.Map      `tfsdk:"triggers"`
+	FailedNotificationIDs types.List     `tfsdk:"failed_notification_ids"`
+	ReplayedAt            types.String   `tfsdk:"replayed_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationReplayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_replay"
+}
+
+// Schema returns the resource schema definition.
+func (r *NotificationReplayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Replays Paddle notifications, for example after an outage of the webhook consumer. Notifications are replayed when the resource is created, and again whenever it is replaced. Destroying it does not call Paddle.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this replay.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_ids": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the notifications to replay (format: ntf_...). Changing them replays the new list.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that replay the notifications again when they change.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"failed_notification_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the notifications Paddle could not replay, or that were not replayed before the create timeout.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replayed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the notifications were replayed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Configure initializes the resource with the Paddle SDK client.
+func (r *NotificationReplayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create replays the notifications. Notifications that cannot be replayed, or
+// are not replayed before the create timeout, are reported as warnings, so that
+// the others are not replayed again on the next apply.
+func (r *NotificationReplayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data notificationReplayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The timeout is handled here rather than with withOperationTimeout, so that
+	// the notifications already replayed are saved instead of tainting the replay
+	replayCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var notificationIDs []string
+	resp.Diagnostics.Append(data.NotificationIDs.ElementsAs(ctx, &notificationIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replay each notification via Paddle API
+	replayedAt := now().UTC()
+	failed := []string{}
+	remaining := 0
+	var replayDiags diag.Diagnostics
+	for i, id := range notificationIDs {
+		if replayCtx.Err() != nil {
+			remaining = len(notificationIDs) - i
+			failed = append(failed, notificationIDs[i:]...)
+			break
+		}
+
+		err := r.client.ReplayNotification(replayCtx, &paddle.ReplayNotificationRequest{NotificationID: id})
+		if err != nil {
+			helpers.AddAPIError(
+				&replayDiags,
+				req.Plan.Schema.Type(),
+				"Error replaying notification",
+				fmt.Sprintf("Could not replay notification ID %s", id),
+				err,
+			)
+			failed = append(failed, id)
+		}
+	}
+
+	if remaining > 0 {
+		replayDiags.AddError(
+			"Timed out during notification replay create",
+			fmt.Sprintf(
+				"%d notification(s) were not replayed within %s and are recorded in failed_notification_ids. "+
+					"Replay them with another paddle_notification_replay, or increase the \"create\" value of the timeouts block.",
+				remaining, createTimeout,
+			),
+		)
+	}
+
+	if len(notificationIDs) > 0 && len(failed) == len(notificationIDs) {
+		resp.Diagnostics.Append(replayDiags...)
+		return
+	}
+	for _, d := range replayDiags {
+		resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+	}
+
+	failedValue, diags := types.ListValueFrom(ctx, types.StringType, failed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(notificationIDs, ",") + "@" + replayedAt.Format(time.RFC3339Nano)))
+	data.ID = types.StringValue(hex.EncodeToString(sum[:8]))
+	data.FailedNotificationIDs = failedValue
+	data.ReplayedAt = types.StringValue(replayedAt.Format(time.RFC3339))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the replay in state, as Paddle does not record replays as objects.
+func (r *NotificationReplayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only records new timeouts, as every other change replays the notifications.
+func (r *NotificationReplayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data notificationReplayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the replay from state. Replayed notifications cannot be undone.
+func (r *NotificationReplayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,121 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Replays notificationIDs against a fake Paddle API that fails to replay the
+// notifications in missing, and does not answer for those in slow. A non-empty
+// createTimeout sets the create value of the timeouts block.
+func createNotificationReplay(t *testing.T, notificationIDs []string, missing, slow map[string]bool, createTimeout string) (*resource.CreateResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var replayed []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /notifications/{id}/replay", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id := r.PathValue("id")
+		if slow[id] {
+			<-r.Context().Done()
+			return
+		}
+		if missing[id] {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"type":"request_error","code":"not_found","detail":"Entity not found"},"meta":{"request_id":"req_01"}}`))
+			return
+		}
+		replayed = append(replayed, id)
+		_, _ = w.Write([]byte(`{"data":{"notification_id":"ntf_new"},"meta":{"request_id":"req_01"}}`))
+	})
+	r := testConfigureResource(t, resources.NewNotificationReplayResource(), "sandbox", mux)
+
+	ids := make([]tftypes.Value, len(notificationIDs))
+	for i, id := range notificationIDs {
+		ids[i] = tftypes.NewValue(tftypes.String, id)
+	}
+	attributes := map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"notification_ids":        tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, ids),
+		"failed_notification_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"replayed_at":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+	if createTimeout != "" {
+		attributes["timeouts"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String}}, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, createTimeout),
+		})
+	}
+	resourceSchema, planRaw := testResourceRaw(t, r, attributes)
+
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: planRaw}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: plan.Raw.Copy()}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	return resp, replayed
+}
+
+func TestNotificationReplayResource_Create(t *testing.T) {
+	ctx := context.Background()
+	resp, replayed := createNotificationReplay(t, []string{"ntf_01", "ntf_02", "ntf_03"}, map[string]bool{"ntf_02": true}, nil, "")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning for ntf_02 but got %v", resp.Diagnostics)
+	}
+	if len(replayed) != 2 || replayed[0] != "ntf_01" || replayed[1] != "ntf_03" {
+		t.Errorf("expected ntf_01 and ntf_03 to be replayed but got %v", replayed)
+	}
+
+	var failed []string
+	resp.State.GetAttribute(ctx, path.Root("failed_notification_ids"), &failed)
+	if len(failed) != 1 || failed[0] != "ntf_02" {
+		t.Errorf("expected failed_notification_ids [ntf_02] but got %v", failed)
+	}
+
+	var id, replayedAt types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.State.GetAttribute(ctx, path.Root("replayed_at"), &replayedAt)
+	if id.ValueString() == "" || replayedAt.ValueString() == "" {
+		t.Errorf("expected id and replayed_at to be set but got %s and %s", id, replayedAt)
+	}
+}
+
+func TestNotificationReplayResource_Create_allFailed(t *testing.T) {
+	resp, _ := createNotificationReplay(t, []string{"ntf_01"}, map[string]bool{"ntf_01": true}, nil, "")
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no notification could be replayed")
+	}
+}
+
+func TestNotificationReplayResource_Create_timeout(t *testing.T) {
+	ctx := context.Background()
+	resp, replayed := createNotificationReplay(t, []string{"ntf_01", "ntf_02", "ntf_03"}, nil, map[string]bool{"ntf_02": true}, "200ms")
+
+	// The notifications already replayed are saved, so that they are not replayed again
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) == 0 {
+		t.Error("expected a warning for the notifications not replayed")
+	}
+	if len(replayed) != 1 || replayed[0] != "ntf_01" {
+		t.Errorf("expected ntf_01 to be replayed but got %v", replayed)
+	}
+
+	var failed []string
+	resp.State.GetAttribute(ctx, path.Root("failed_notification_ids"), &failed)
+	if len(failed) != 2 || failed[0] != "ntf_02" || failed[1] != "ntf_03" {
+		t.Errorf("expected failed_notification_ids [ntf_02 ntf_03] but got %v", failed)
+	}
+}
@@ -3,20 +3,37 @@ package resources
 import (
 	"context"
 	"fmt"
-
+	"net/mail"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
 	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationSettingResource{}
 var _ resource.ResourceWithImportState = &NotificationSettingResource{}
+var _ resource.ResourceWithUpgradeState = &NotificationSettingResource{}
+var _ resource.ResourceWithIdentity = &NotificationSettingResource{}
+var _ resource.ResourceWithModifyPlan = &NotificationSettingResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationSettingResource{}
 
 // Creates a new Paddle notification setting resource.
 func NewNotificationSettingResource() resource.Resource {
@@ -25,31 +42,47 @@ func NewNotificationSettingResource() resource.Resource {
 
 // Manages Paddle notification settings (webhooks).
 type NotificationSettingResource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 // notificationSettingResourceModel describes the resource data model.
 type notificationSettingResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Description            types.String `tfsdk:"description"`
-	Type                   types.String `tfsdk:"type"`
-	Destination            types.String `tfsdk:"destination"`
-	Active                 types.Bool   `tfsdk:"active"`
-	SubscribedEvents       types.List   `tfsdk:"subscribed_events"`
-	EndpointSecretKey      types.String `tfsdk:"endpoint_secret_key"`
-	APIVersion             types.Int64  `tfsdk:"api_version"`
-	IncludeSensitiveFields types.Bool   `tfsdk:"include_sensitive_fields"`
-	TrafficSource          types.String `tfsdk:"traffic_source"`
+	ID                     types.String              `tfsdk:"id"`
+	Description            types.String              `tfsdk:"description"`
+	Type                   types.String              `tfsdk:"type"`
+	Destination            types.String              `tfsdk:"destination"`
+	Active                 types.Bool                `tfsdk:"active"`
+	SubscribedEvents       customtypes.UnorderedList `tfsdk:"subscribed_events"`
+	EndpointSecretKey      types.String              `tfsdk:"endpoint_secret_key"`
+	APIVersion             types.Int64               `tfsdk:"api_version"`
+	IncludeSensitiveFields types.Bool                `tfsdk:"include_sensitive_fields"`
+	TrafficSource          types.String              `tfsdk:"traffic_source"`
+	RotationTrigger        types.String              `tfsdk:"rotation_trigger"`
+	RotationOverlap        types.String              `tfsdk:"rotation_overlap"`
+	RotationStartedAt      types.String              `tfsdk:"rotation_started_at"`
+	PreviousID             types.String              `tfsdk:"previous_id"`
+	PreviousEndpointSecret types.String              `tfsdk:"previous_endpoint_secret_key"`
+	Timeouts               timeouts.Value            `tfsdk:"timeouts"`
 }
 
+// How long the previous notification setting stays active after a rotation,
+// when rotation_overlap is not set.
+const defaultRotationOverlap = 24 * time.Hour
+
+// Returns the current time. Replaced in tests.
+var now = time.Now
+
 // Metadata returns the resource type name.
 func (r *NotificationSettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notification_setting"
+	// Rotating the endpoint secret replaces the Paddle notification setting, and its ID
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema returns the resource schema definition.
 func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Paddle notification setting resource. Notification settings allow you to subscribe to events and receive webhooks.",
 
 		Attributes: map[string]schema.Attribute{
@@ -68,6 +101,9 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Type of notification destination. One of: `url` (webhook), `email`. Defaults to `url`. Cannot be changed after creation.",
+				Validators: []validator.String{
+					validators.NotificationSettingTypeValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
@@ -75,7 +111,7 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 			},
 			"destination": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Webhook endpoint URL or email address for notifications. URLs must be HTTPS.",
+				MarkdownDescription: "Webhook endpoint URL or email address for notifications. Must be an HTTPS URL for `url` destinations and an email address for `email` destinations.",
 			},
 			"active": schema.BoolAttribute{
 				Optional:            true,
@@ -85,7 +121,8 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 			"subscribed_events": schema.ListAttribute{
 				Required:            true,
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of event types to subscribe to (e.g., 'transaction.completed', 'subscription.activated').",
+				CustomType:          customtypes.NewUnorderedListType(types.StringType),
+				MarkdownDescription: "List of event types to subscribe to (e.g., 'transaction.completed', 'subscription.activated'). Wildcards such as 'subscription.*' subscribe to every event type of an entity.",
 			},
 			"endpoint_secret_key": schema.StringAttribute{
 				Computed:            true,
@@ -96,8 +133,9 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"api_version": schema.Int64Attribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "API version for event payloads.",
+				MarkdownDescription: "API version that event payloads conform to. Defaults to the account default version, and cannot be older than it. Only applies to `url` destinations.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -105,7 +143,7 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 			"include_sensitive_fields": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Whether to include sensitive fields in webhook payloads.",
+				MarkdownDescription: "Whether to include sensitive fields in webhook payloads. Only applies to `url` destinations.",
 			},
 			"traffic_source": schema.StringAttribute{
 				Optional:            true,
@@ -115,21 +153,55 @@ func (r *NotificationSettingResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value that rotates the endpoint secret when it changes. A new notification setting is created alongside the current one, which stays active for `rotation_overlap`. Setting it for the first time does not rotate.",
+			},
+			"rotation_overlap": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long the previous notification setting stays active after a rotation, as a duration such as `30m` or `24h`. Defaults to `24h`. Once it has elapsed, the next apply deactivates the previous notification setting.",
+			},
+			"rotation_started_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the current rotation started. Null when no previous notification setting is active.",
+			},
+			"previous_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the notification setting replaced by the current rotation, which stays active until `rotation_overlap` has elapsed.",
+			},
+			"previous_endpoint_secret_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Webhook secret key of the notification setting replaced by the current rotation. Webhook consumers should accept both secrets during the overlap.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// IdentitySchema returns the resource identity schema definition.
+func (r *NotificationSettingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle notification setting ID (format: ntfset_...)")
+}
+
 // Configure initializes the resource with the Paddle SDK client.
 func (r *NotificationSettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -147,67 +219,26 @@ func (r *NotificationSettingResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	// Convert subscribed_events from types.List to []paddle.EventTypeName
-	var subscribedEventsStr []string
-	resp.Diagnostics.Append(data.SubscribedEvents.ElementsAs(ctx, &subscribedEventsStr, false)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	subscribedEvents := make([]paddle.EventTypeName, len(subscribedEventsStr))
-	for i, event := range subscribedEventsStr {
-		subscribedEvents[i] = paddle.EventTypeName(event)
-	}
-
-	// Build create request
-	createReq := &paddle.CreateNotificationSettingRequest{
-		Description:      data.Description.ValueString(),
-		Destination:      data.Destination.ValueString(),
-		SubscribedEvents: subscribedEvents,
-	}
-
-	if !data.Type.IsNull() && !data.Type.IsUnknown() {
-		createReq.Type = paddle.NotificationSettingType(data.Type.ValueString())
-	} else {
-		createReq.Type = paddle.NotificationSettingTypeURL
-	}
-
-	if !data.IncludeSensitiveFields.IsNull() {
-		includeSensitive := data.IncludeSensitiveFields.ValueBool()
-		createReq.IncludeSensitiveFields = &includeSensitive
-	}
-
-	if !data.TrafficSource.IsNull() && !data.TrafficSource.IsUnknown() {
-		trafficSource := paddle.TrafficSource(data.TrafficSource.ValueString())
-		createReq.TrafficSource = &trafficSource
-	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "notification setting", "create", createTimeout)
+	defer done()
 
 	// Create notification setting via Paddle API
-	notifSetting, err := r.client.CreateNotificationSetting(ctx, createReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating notification setting",
-			fmt.Sprintf("Could not create notification setting: %s", err.Error()),
-		)
+	r.createNotificationSetting(ctx, &data, req.Plan.Schema.Type(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Map response to model
-	data.ID = types.StringValue(notifSetting.ID)
-	data.Type = types.StringValue(string(notifSetting.Type))
-	data.Active = types.BoolValue(notifSetting.Active)
-	data.EndpointSecretKey = types.StringValue(notifSetting.EndpointSecretKey)
-	data.APIVersion = types.Int64Value(int64(notifSetting.APIVersion))
-	data.IncludeSensitiveFields = types.BoolValue(notifSetting.IncludeSensitiveFields)
-
-	if notifSetting.TrafficSource != "" {
-		data.TrafficSource = types.StringValue(string(notifSetting.TrafficSource))
-	} else {
-		data.TrafficSource = types.StringNull()
-	}
+	data.PreviousID = types.StringNull()
+	data.PreviousEndpointSecret = types.StringNull()
+	data.RotationStartedAt = types.StringNull()
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Read retrieves the current state of a Paddle notification setting.
@@ -220,101 +251,119 @@ func (r *NotificationSettingResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "notification setting", "read", readTimeout)
+	defer done()
+
 	// Read notification setting from Paddle API
 	notifSetting, err := r.client.GetNotificationSetting(ctx, &paddle.GetNotificationSettingRequest{
 		NotificationSettingID: data.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error reading notification setting",
-			fmt.Sprintf("Could not read notification setting ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read notification setting ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 
-	// Update model with response data
-	data.Description = types.StringValue(notifSetting.Description)
-	data.Type = types.StringValue(string(notifSetting.Type))
-	data.Destination = types.StringValue(notifSetting.Destination)
-	data.Active = types.BoolValue(notifSetting.Active)
-	data.EndpointSecretKey = types.StringValue(notifSetting.EndpointSecretKey)
-	data.APIVersion = types.Int64Value(int64(notifSetting.APIVersion))
-	data.IncludeSensitiveFields = types.BoolValue(notifSetting.IncludeSensitiveFields)
-
-	// Map traffic_source if present
-	if notifSetting.TrafficSource != "" {
-		data.TrafficSource = types.StringValue(string(notifSetting.TrafficSource))
-	} else {
-		data.TrafficSource = types.StringNull()
-	}
-
-	// For subscribed_events, preserve the order from state to avoid drift
-	// Paddle may return events in a different order
-	var stateEvents []string
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("subscribed_events"), &stateEvents)...)
+	// Update model with response data, keeping the wildcards of subscribed_events
+	// while they still expand to the events Paddle sends
+	priorEvents := data.SubscribedEvents
+	resp.Diagnostics.Append(notificationSettingToModel(ctx, notifSetting, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Convert API response to a map for lookup
-	apiEventsMap := make(map[string]bool)
-	for _, event := range notifSetting.SubscribedEvents {
-		apiEventsMap[string(event.Name)] = true
-	}
-
-	// Verify all state events are still in API response, use state order
-	orderedEvents := make([]string, 0, len(stateEvents))
-	for _, event := range stateEvents {
-		if apiEventsMap[event] {
-			orderedEvents = append(orderedEvents, event)
-		}
-	}
-
-	// Add any new events from API that aren't in state (shouldn't happen normally)
-	for _, event := range notifSetting.SubscribedEvents {
-		found := false
-		for _, stateEvent := range stateEvents {
-			if string(event.Name) == stateEvent {
-				found = true
-				break
-			}
-		}
-		if !found {
-			orderedEvents = append(orderedEvents, string(event.Name))
-		}
-	}
-
-	eventList, diags := types.ListValueFrom(ctx, types.StringType, orderedEvents)
-	resp.Diagnostics.Append(diags...)
+	data.SubscribedEvents = r.keepSubscribedEventPatterns(ctx, priorEvents, data.SubscribedEvents, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.SubscribedEvents = eventList
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Update modifies an existing Paddle notification setting.
 func (r *NotificationSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data notificationSettingResourceModel
+	var data, state notificationSettingResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert subscribed_events from types.List to []paddle.EventTypeName
-	var subscribedEventsStr []string
-	resp.Diagnostics.Append(data.SubscribedEvents.ElementsAs(ctx, &subscribedEventsStr, false)...)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	subscribedEvents := make([]paddle.EventTypeName, len(subscribedEventsStr))
-	for i, event := range subscribedEventsStr {
-		subscribedEvents[i] = paddle.EventTypeName(event)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "notification setting", "update", updateTimeout)
+	defer done()
+
+	// Deactivate the notification setting replaced by the previous rotation once
+	// its overlap has elapsed, or before it is replaced by a new rotation
+	rotating := rotationTriggered(state, data)
+	if !state.PreviousID.IsNull() && (rotating || data.PreviousID.IsNull()) {
+		if !rotationOverlapElapsed(state, data.RotationOverlap) {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation_trigger"), "Rotation In Progress", rotationInProgressDetail(state, data.RotationOverlap))
+			return
+		}
+		r.deactivateNotificationSetting(ctx, state.PreviousID.ValueString(), req.Plan.Schema.Type(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.PreviousID = types.StringNull()
+		data.PreviousEndpointSecret = types.StringNull()
+		data.RotationStartedAt = types.StringNull()
+	} else if data.PreviousID.IsUnknown() {
+		// The trigger was unknown when planning and turned out unchanged
+		data.ID = state.ID
+		data.EndpointSecretKey = state.EndpointSecretKey
+		data.PreviousID = state.PreviousID
+		data.PreviousEndpointSecret = state.PreviousEndpointSecret
+		data.RotationStartedAt = state.RotationStartedAt
+	}
+
+	// Rotate the endpoint secret by creating a new notification setting, and
+	// keep the current one active until the overlap has elapsed
+	if rotating {
+		r.createNotificationSetting(ctx, &data, req.Plan.Schema.Type(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.PreviousID = state.ID
+		data.PreviousEndpointSecret = state.EndpointSecretKey
+		data.RotationStartedAt = types.StringValue(now().UTC().Format(time.RFC3339))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+		return
+	}
+
+	// Expand wildcards in subscribed_events into event type names
+	subscribedEvents := r.expandSubscribedEvents(ctx, data.SubscribedEvents, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Build update request
@@ -333,6 +382,11 @@ func (r *NotificationSettingResource) Update(ctx context.Context, req resource.U
 		updateReq.IncludeSensitiveFields = paddle.NewPatchField(data.IncludeSensitiveFields.ValueBool())
 	}
 
+	// api_version only applies to webhooks, although Paddle returns one for every destination
+	if !data.APIVersion.IsNull() && !data.APIVersion.IsUnknown() && data.Type.ValueString() != string(paddle.NotificationSettingTypeEmail) {
+		updateReq.APIVersion = paddle.NewPatchField(int(data.APIVersion.ValueInt64()))
+	}
+
 	if !data.TrafficSource.IsNull() && !data.TrafficSource.IsUnknown() {
 		trafficSource := paddle.TrafficSource(data.TrafficSource.ValueString())
 		updateReq.TrafficSource = paddle.NewPatchField(trafficSource)
@@ -343,9 +397,12 @@ func (r *NotificationSettingResource) Update(ctx context.Context, req resource.U
 	// Update notification setting via Paddle API
 	notifSetting, err := r.client.UpdateNotificationSetting(ctx, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error updating notification setting",
-			fmt.Sprintf("Could not update notification setting ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update notification setting ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
@@ -362,6 +419,7 @@ func (r *NotificationSettingResource) Update(ctx context.Context, req resource.U
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Delete removes a Paddle notification setting.
@@ -374,20 +432,445 @@ func (r *NotificationSettingResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "notification setting", "delete", deleteTimeout)
+	defer done()
+
+	// Delete the notification setting replaced by a rotation still in its overlap
+	if !data.PreviousID.IsNull() {
+		err := r.client.DeleteNotificationSetting(ctx, &paddle.DeleteNotificationSettingRequest{
+			NotificationSettingID: data.PreviousID.ValueString(),
+		})
+		if err != nil {
+			helpers.AddAPIError(
+				&resp.Diagnostics,
+				req.State.Schema.Type(),
+				"Error deleting notification setting",
+				fmt.Sprintf("Could not delete previous notification setting ID %s", data.PreviousID.ValueString()),
+				err,
+			)
+			return
+		}
+	}
+
 	// Delete notification setting via Paddle API
 	err := r.client.DeleteNotificationSetting(ctx, &paddle.DeleteNotificationSettingRequest{
 		NotificationSettingID: data.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error deleting notification setting",
-			fmt.Sprintf("Could not delete notification setting ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not delete notification setting ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ValidateConfig checks that destination matches the destination type, and that
+// attributes which only apply to webhooks are not set on email destinations.
+func (r *NotificationSettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data notificationSettingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingType := paddle.NotificationSettingTypeURL
+	if !data.Type.IsNull() {
+		settingType = paddle.NotificationSettingType(data.Type.ValueString())
+	}
+
+	if !data.Type.IsUnknown() && !data.Destination.IsNull() && !data.Destination.IsUnknown() {
+		if detail := invalidDestination(settingType, data.Destination.ValueString()); detail != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("destination"), "Invalid Destination", detail)
+		}
+	}
+
+	if !data.RotationOverlap.IsUnknown() {
+		if _, err := rotationOverlap(data.RotationOverlap); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rotation_overlap"),
+				"Invalid Rotation Overlap",
+				fmt.Sprintf("rotation_overlap must be a positive duration, such as 30m or 24h: %s", err),
+			)
+		}
+	}
+
+	if data.Type.IsUnknown() || settingType != paddle.NotificationSettingTypeEmail {
+		return
+	}
+	webhookOnly := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"api_version", data.APIVersion},
+		{"include_sensitive_fields", data.IncludeSensitiveFields},
+	}
+	for _, attribute := range webhookOnly {
+		if !attribute.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Attribute Not Supported",
+				fmt.Sprintf("%s only applies to webhook destinations, and cannot be set when type is \"email\".", attribute.name),
+			)
+		}
+	}
+}
+
+// Explains why destination is not valid for a notification setting type, or
+// returns an empty string when it is.
+func invalidDestination(settingType paddle.NotificationSettingType, destination string) string {
+	switch settingType {
+	case paddle.NotificationSettingTypeURL:
+		u, err := url.Parse(destination)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Sprintf("Webhook destinations must be HTTPS URLs, such as https://example.com/webhook. Got: %s", destination)
+		}
+	case paddle.NotificationSettingTypeEmail:
+		address, err := mail.ParseAddress(destination)
+		if err != nil || address.Address != destination {
+			return fmt.Sprintf("Email destinations must be a single email address, such as billing@example.com. Got: %s", destination)
+		}
+	}
+	return ""
+}
+
+// ModifyPlan checks that subscribed_events only names event types Paddle can
+// send, so that typos are reported at plan time rather than by the API.
+func (r *NotificationSettingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var subscribedEvents customtypes.UnorderedList
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("subscribed_events"), &subscribedEvents)...)
+	if resp.Diagnostics.HasError() || subscribedEvents.IsNull() || subscribedEvents.IsUnknown() {
+		return
+	}
+
+	var patterns []types.String
+	resp.Diagnostics.Append(subscribedEvents.ElementsAs(ctx, &patterns, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := knownEventTypes(ctx, r.client)
+	for i, pattern := range patterns {
+		if pattern.IsNull() || pattern.IsUnknown() {
+			continue
+		}
+		if _, unknown := eventtypes.Expand([]string{pattern.ValueString()}, known); len(unknown) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subscribed_events").AtListIndex(i),
+				"Unknown Event Type",
+				eventtypes.Hint(pattern.ValueString(), known),
+			)
+		}
+	}
+
+	// Rotations only apply to existing notification settings
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state notificationSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRotation(state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Plans the rotation attributes. Changing rotation_trigger replaces the
+// notification setting by a new one, with a new ID and endpoint secret, and
+// keeps the current one as the previous notification setting. Once the overlap
+// has elapsed, the previous notification setting is planned to be deactivated.
+func planRotation(state notificationSettingResourceModel, plan *notificationSettingResourceModel, diags *diag.Diagnostics) {
+	switch {
+	case plan.RotationTrigger.IsUnknown() && !state.RotationTrigger.IsNull():
+		// The notification setting is rotated if the trigger turns out to change
+		plan.ID = types.StringUnknown()
+		plan.EndpointSecretKey = types.StringUnknown()
+		plan.PreviousID = types.StringUnknown()
+		plan.PreviousEndpointSecret = types.StringUnknown()
+		plan.RotationStartedAt = types.StringUnknown()
+
+	case rotationTriggered(state, *plan):
+		if !state.PreviousID.IsNull() && !rotationOverlapElapsed(state, plan.RotationOverlap) {
+			diags.AddAttributeError(path.Root("rotation_trigger"), "Rotation In Progress", rotationInProgressDetail(state, plan.RotationOverlap))
+			return
+		}
+		plan.ID = types.StringUnknown()
+		plan.EndpointSecretKey = types.StringUnknown()
+		plan.PreviousID = state.ID
+		plan.PreviousEndpointSecret = state.EndpointSecretKey
+		plan.RotationStartedAt = types.StringUnknown()
+
+	case !state.PreviousID.IsNull() && rotationOverlapElapsed(state, plan.RotationOverlap):
+		plan.PreviousID = types.StringNull()
+		plan.PreviousEndpointSecret = types.StringNull()
+		plan.RotationStartedAt = types.StringNull()
+
+	default:
+		plan.PreviousID = state.PreviousID
+		plan.PreviousEndpointSecret = state.PreviousEndpointSecret
+		plan.RotationStartedAt = state.RotationStartedAt
+	}
+}
+
+// Reports whether rotation_trigger changed from a value recorded in state to
+// another value. Removing rotation_trigger does not rotate.
+func rotationTriggered(state, plan notificationSettingResourceModel) bool {
+	return !state.RotationTrigger.IsNull() && !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.IsUnknown() && !plan.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+// Returns rotation_overlap, or the default overlap when it is not set.
+func rotationOverlap(value types.String) (time.Duration, error) {
+	if value.IsNull() {
+		return defaultRotationOverlap, nil
+	}
+
+	overlap, err := time.ParseDuration(value.ValueString())
+	if err == nil && overlap <= 0 {
+		err = fmt.Errorf("%s is not positive", value.ValueString())
+	}
+	return overlap, err
+}
+
+// Reports whether the previous notification setting of a rotation has been
+// active for the whole overlap. An overlap that is not known yet has not elapsed.
+func rotationOverlapElapsed(state notificationSettingResourceModel, overlapValue types.String) bool {
+	overlap, err := rotationOverlap(overlapValue)
+	if overlapValue.IsUnknown() || err != nil {
+		return false
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, state.RotationStartedAt.ValueString())
+	if err != nil {
+		return true
+	}
+	return !now().Before(startedAt.Add(overlap))
+}
+
+// Explains that a rotation cannot start before the previous one is over.
+func rotationInProgressDetail(state notificationSettingResourceModel, overlapValue types.String) string {
+	overlap, _ := rotationOverlap(overlapValue)
+	return fmt.Sprintf(
+		"The rotation started at %s keeps notification setting %s active for %s. "+
+			"Apply again once the overlap has elapsed to deactivate it, then change rotation_trigger to rotate again.",
+		state.RotationStartedAt.ValueString(), state.PreviousID.ValueString(), overlap,
+	)
+}
+
+// Deactivates the notification setting replaced by a rotation.
+func (r *NotificationSettingResource) deactivateNotificationSetting(ctx context.Context, id string, schemaType attr.Type, diags *diag.Diagnostics) {
+	_, err := r.client.UpdateNotificationSetting(ctx, &paddle.UpdateNotificationSettingRequest{
+		NotificationSettingID: id,
+		Active:                paddle.NewPatchField(false),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			diags,
+			schemaType,
+			"Error deactivating notification setting",
+			fmt.Sprintf("Could not deactivate previous notification setting ID %s", id),
+			err,
 		)
+	}
+}
+
+// Returns the event types configurations are checked against: those read from
+// Paddle, or the ones embedded in the provider before it is configured.
+func knownEventTypes(ctx context.Context, client *paddleclient.Client) []*paddle.EventType {
+	if client == nil {
+		return eventtypes.Embedded()
+	}
+	return client.KnownEventTypes(ctx)
+}
+
+// Creates a notification setting from the model, and copies the attributes
+// Paddle computes into it.
+func (r *NotificationSettingResource) createNotificationSetting(ctx context.Context, data *notificationSettingResourceModel, schemaType attr.Type, diags *diag.Diagnostics) {
+	// Expand wildcards in subscribed_events into event type names
+	subscribedEvents := r.expandSubscribedEvents(ctx, data.SubscribedEvents, diags)
+	if diags.HasError() {
 		return
 	}
+
+	// Build create request
+	createReq := &paddle.CreateNotificationSettingRequest{
+		Description:      data.Description.ValueString(),
+		Destination:      data.Destination.ValueString(),
+		SubscribedEvents: subscribedEvents,
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		createReq.Type = paddle.NotificationSettingType(data.Type.ValueString())
+	} else {
+		createReq.Type = paddle.NotificationSettingTypeURL
+	}
+
+	if !data.IncludeSensitiveFields.IsNull() && !data.IncludeSensitiveFields.IsUnknown() {
+		includeSensitive := data.IncludeSensitiveFields.ValueBool()
+		createReq.IncludeSensitiveFields = &includeSensitive
+	}
+
+	if !data.APIVersion.IsNull() && !data.APIVersion.IsUnknown() {
+		apiVersion := int(data.APIVersion.ValueInt64())
+		createReq.APIVersion = &apiVersion
+	}
+
+	if !data.TrafficSource.IsNull() && !data.TrafficSource.IsUnknown() {
+		trafficSource := paddle.TrafficSource(data.TrafficSource.ValueString())
+		createReq.TrafficSource = &trafficSource
+	}
+
+	// Create notification setting via Paddle API
+	notifSetting, err := r.client.CreateNotificationSetting(ctx, createReq)
+	if err != nil {
+		helpers.AddAPIError(
+			diags,
+			schemaType,
+			"Error creating notification setting",
+			"Could not create notification setting",
+			err,
+		)
+		return
+	}
+
+	// Map response to model
+	data.ID = types.StringValue(notifSetting.ID)
+	data.Type = types.StringValue(string(notifSetting.Type))
+	data.Active = types.BoolValue(notifSetting.Active)
+	data.EndpointSecretKey = types.StringValue(notifSetting.EndpointSecretKey)
+	data.APIVersion = types.Int64Value(int64(notifSetting.APIVersion))
+	data.IncludeSensitiveFields = types.BoolValue(notifSetting.IncludeSensitiveFields)
+
+	if notifSetting.TrafficSource != "" {
+		data.TrafficSource = types.StringValue(string(notifSetting.TrafficSource))
+	} else {
+		data.TrafficSource = types.StringNull()
+	}
+}
+
+// Returns the event type names subscribed_events resolves to.
+func (r *NotificationSettingResource) expandSubscribedEvents(ctx context.Context, subscribedEvents customtypes.UnorderedList, diags *diag.Diagnostics) []paddle.EventTypeName {
+	var patterns []string
+	diags.Append(subscribedEvents.ElementsAs(ctx, &patterns, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	known := knownEventTypes(ctx, r.client)
+	names, unknown := eventtypes.Expand(patterns, known)
+	for _, pattern := range unknown {
+		diags.AddAttributeError(path.Root("subscribed_events"), "Unknown Event Type", eventtypes.Hint(pattern, known))
+	}
+
+	eventTypeNames := make([]paddle.EventTypeName, len(names))
+	for i, name := range names {
+		eventTypeNames[i] = paddle.EventTypeName(name)
+	}
+	return eventTypeNames
+}
+
+// Returns the prior subscribed_events when they contain wildcards that still
+// expand to the events Paddle sends, and the events read from Paddle otherwise.
+func (r *NotificationSettingResource) keepSubscribedEventPatterns(ctx context.Context, prior, read customtypes.UnorderedList, diags *diag.Diagnostics) customtypes.UnorderedList {
+	if prior.IsNull() || prior.IsUnknown() {
+		return read
+	}
+
+	var patterns, events []string
+	diags.Append(prior.ElementsAs(ctx, &patterns, false)...)
+	diags.Append(read.ElementsAs(ctx, &events, false)...)
+	if diags.HasError() || !slices.ContainsFunc(patterns, eventtypes.IsWildcard) {
+		return read
+	}
+
+	names, unknown := eventtypes.Expand(patterns, knownEventTypes(ctx, r.client))
+	slices.Sort(names)
+	slices.Sort(events)
+	if len(unknown) > 0 || !slices.Equal(names, slices.Compact(events)) {
+		return read
+	}
+	return prior
+}
+
+// Copies the attributes of a Paddle notification setting into the resource model.
+func notificationSettingToModel(ctx context.Context, notifSetting *paddle.NotificationSetting, data *notificationSettingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(notifSetting.ID)
+	data.Description = types.StringValue(notifSetting.Description)
+	data.Type = types.StringValue(string(notifSetting.Type))
+	data.Destination = types.StringValue(notifSetting.Destination)
+	data.Active = types.BoolValue(notifSetting.Active)
+	data.EndpointSecretKey = types.StringValue(notifSetting.EndpointSecretKey)
+	data.APIVersion = types.Int64Value(int64(notifSetting.APIVersion))
+	data.IncludeSensitiveFields = types.BoolValue(notifSetting.IncludeSensitiveFields)
+
+	// Map traffic_source if present
+	if notifSetting.TrafficSource != "" {
+		data.TrafficSource = types.StringValue(string(notifSetting.TrafficSource))
+	} else {
+		data.TrafficSource = types.StringNull()
+	}
+
+	// Paddle may return subscribed events in a different order; the unordered
+	// list type keeps the configured order in state when the set is unchanged.
+	apiEvents := make([]string, len(notifSetting.SubscribedEvents))
+	for i, event := range notifSetting.SubscribedEvents {
+		apiEvents[i] = string(event.Name)
+	}
+
+	eventList, d := customtypes.NewUnorderedListValueFrom(ctx, types.StringType, apiEvents)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.SubscribedEvents = eventList
+
+	return diags
 }
 
 // Imports an existing Paddle notification setting by its ID.
 func (r *NotificationSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// State migrations, indexed by the schema version they upgrade from.
+var notificationSettingStateMigrations = []stateMigration{
+	// 0 -> 1: subscribed_events became an order-insensitive list.
+	unchangedState,
+}
+
+// UpgradeState migrates state from earlier schema versions.
+func (r *NotificationSettingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(ctx, r, notificationSettingStateMigrations)
 }
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &NotificationSettingListResource{}
+var _ list.ListResourceWithConfigure = &NotificationSettingListResource{}
+
+// Creates a new Paddle notification setting list resource.
+func NewNotificationSettingListResource() list.ListResource {
+	return &NotificationSettingListResource{}
+}
+
+// Finds Paddle notification settings (webhooks) for `terraform query`.
+type NotificationSettingListResource struct {
+	client *paddleclient.Client
+}
+
+// notificationSettingListModel describes the filters of a list block.
+type notificationSettingListModel struct {
+	Active        types.Bool   `tfsdk:"active"`
+	TrafficSource types.String `tfsdk:"traffic_source"`
+	OrderBy       types.String `tfsdk:"order_by"`
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationSettingListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_setting"
+}
+
+// ListResourceConfigSchema returns the filters supported by the list block.
+func (r *NotificationSettingListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Paddle notification settings, using the filters of the list notification settings endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only active (`true`) or inactive (`false`) notification settings.",
+			},
+			"traffic_source": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only notification settings receiving this traffic: 'platform', 'simulation' or 'all'.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Order notification settings by a field and direction, for example `id[DESC]`.",
+			},
+		},
+	}
+}
+
+// Configure initializes the list resource with the Paddle SDK client.
+func (r *NotificationSettingListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+// List streams the Paddle notification settings matching the filters.
+func (r *NotificationSettingListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config notificationSettingListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listReq := &paddle.ListNotificationSettingsRequest{
+		Active:        boolFilter(config.Active),
+		TrafficSource: stringFilter(config.TrafficSource),
+		OrderBy:       stringFilter(config.OrderBy),
+	}
+
+	stream.Results = listResults(ctx, req, r.client, "notification settings",
+		func(ctx context.Context, perPage int) (*paddle.Collection[*paddle.NotificationSetting], error) {
+			listReq.PerPage = &perPage
+			return r.client.ListNotificationSettings(ctx, listReq)
+		},
+		func(notifSetting *paddle.NotificationSetting) (string, string) {
+			return notifSetting.ID, notifSetting.Description
+		},
+		notificationSettingToModel,
+	)
+}
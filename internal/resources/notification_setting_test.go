@@ -1,8 +1,21 @@
 package resources_test
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -57,6 +70,24 @@ func TestAccNotificationSettingResource_withOptionalFields(t *testing.T) {
 					resource.TestCheckResourceAttr("paddle_notification_setting.test", "description", "Full webhook"),
 					resource.TestCheckResourceAttr("paddle_notification_setting.test", "include_sensitive_fields", "true"),
 					resource.TestCheckResourceAttr("paddle_notification_setting.test", "traffic_source", "platform"),
+					resource.TestCheckResourceAttr("paddle_notification_setting.test", "api_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationSettingResource_email(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSettingResourceConfigEmail(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_notification_setting.test", "type", "email"),
+					resource.TestCheckResourceAttr("paddle_notification_setting.test", "destination", "billing@example.com"),
 				),
 			},
 		},
@@ -105,6 +136,7 @@ resource "paddle_notification_setting" "test" {
   active                   = true
   include_sensitive_fields = true
   traffic_source          = "platform"
+  api_version              = 1
   
   subscribed_events = [
     "transaction.completed",
@@ -114,6 +146,20 @@ resource "paddle_notification_setting" "test" {
 `
 }
 
+func testAccNotificationSettingResourceConfigEmail() string {
+	return `
+resource "paddle_notification_setting" "test" {
+  description = "Billing alerts"
+  destination = "billing@example.com"
+  type        = "email"
+
+  subscribed_events = [
+    "transaction.payment_failed"
+  ]
+}
+`
+}
+
 func testAccCheckNotificationSettingDestroy(s *terraform.State) error {
 	// Notification settings can be hard-deleted
 	for _, rs := range s.RootModule().Resources {
@@ -123,3 +169,476 @@ func testAccCheckNotificationSettingDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+// Returns a notification setting resource object whose attributes are null
+// except id and subscribed_events.
+func testNotificationSettingValue(t *testing.T, r fwresource.Resource, subscribedEvents ...string) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+
+	events := make([]tftypes.Value, len(subscribedEvents))
+	for i, event := range subscribedEvents {
+		events[i] = tftypes.NewValue(tftypes.String, event)
+	}
+	resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "ntfset_01"),
+		"subscribed_events": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, events),
+	})
+
+	return tfsdk.State{Schema: resourceSchema, Raw: raw}, tfsdk.Plan{Schema: resourceSchema, Raw: raw.Copy()}
+}
+
+func TestNotificationSettingResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]tftypes.Value
+		errorPaths []string
+	}{
+		{
+			name: "webhook",
+			attributes: map[string]tftypes.Value{
+				"destination": tftypes.NewValue(tftypes.String, "https://example.com/webhook"),
+				"api_version": tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		{
+			name: "webhook without https",
+			attributes: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "url"),
+				"destination": tftypes.NewValue(tftypes.String, "http://example.com/webhook"),
+			},
+			errorPaths: []string{"destination"},
+		},
+		{
+			name: "webhook to an email address",
+			attributes: map[string]tftypes.Value{
+				"destination": tftypes.NewValue(tftypes.String, "billing@example.com"),
+			},
+			errorPaths: []string{"destination"},
+		},
+		{
+			name: "email",
+			attributes: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "email"),
+				"destination": tftypes.NewValue(tftypes.String, "billing@example.com"),
+			},
+		},
+		{
+			name: "email with display name",
+			attributes: map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "email"),
+				"destination": tftypes.NewValue(tftypes.String, "Billing <billing@example.com>"),
+			},
+			errorPaths: []string{"destination"},
+		},
+		{
+			name: "email with webhook attributes",
+			attributes: map[string]tftypes.Value{
+				"type":                     tftypes.NewValue(tftypes.String, "email"),
+				"destination":              tftypes.NewValue(tftypes.String, "billing@example.com"),
+				"api_version":              tftypes.NewValue(tftypes.Number, 1),
+				"include_sensitive_fields": tftypes.NewValue(tftypes.Bool, false),
+			},
+			errorPaths: []string{"api_version", "include_sensitive_fields"},
+		},
+		{
+			name: "unknown destination",
+			attributes: map[string]tftypes.Value{
+				"destination": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewNotificationSettingResource()
+			resourceSchema, raw := testResourceRaw(t, r, tt.attributes)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: resourceSchema, Raw: raw},
+			}, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.errorPaths) {
+				t.Fatalf("expected errors on %v but got %v", tt.errorPaths, resp.Diagnostics)
+			}
+			for i, errorPath := range tt.errorPaths {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(path.Root(errorPath)) {
+					t.Errorf("expected an error on %s but got %v", errorPath, errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationSettingResource_ModifyPlan_subscribedEvents(t *testing.T) {
+	ctx := context.Background()
+	r := resources.NewNotificationSettingResource()
+	_, plan := testNotificationSettingValue(t, r, "transaction.complete", "subscription.*", "transaction.completed", "payouts.*")
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors but got %v", resp.Diagnostics)
+	}
+	for i, index := range []int{0, 3} {
+		withPath, ok := errs[i].(interface{ Path() path.Path })
+		if !ok || !withPath.Path().Equal(path.Root("subscribed_events").AtListIndex(index)) {
+			t.Errorf("expected an error on subscribed_events[%d] but got %v", index, errs[i])
+		}
+	}
+	if !strings.Contains(errs[0].Detail(), "transaction.completed") {
+		t.Errorf("expected the transaction event types in %q", errs[0].Detail())
+	}
+}
+
+func TestNotificationSettingResource_Read_wildcards(t *testing.T) {
+	subscriptionEvents := []string{
+		"subscription.activated", "subscription.canceled", "subscription.created",
+		"subscription.imported", "subscription.past_due", "subscription.paused",
+		"subscription.resumed", "subscription.trialing", "subscription.updated",
+	}
+
+	tests := []struct {
+		name      string
+		apiEvents []string
+		expected  []string
+	}{
+		{
+			name:      "wildcard still matches",
+			apiEvents: append([]string{"transaction.completed"}, subscriptionEvents...),
+			expected:  []string{"subscription.*", "transaction.completed"},
+		},
+		{
+			name:      "events changed outside Terraform",
+			apiEvents: []string{"subscription.created", "transaction.completed"},
+			expected:  []string{"subscription.created", "transaction.completed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			events := make([]string, len(tt.apiEvents))
+			for i, event := range tt.apiEvents {
+				events[i] = fmt.Sprintf(`{"name":%q}`, event)
+			}
+			r := testConfigureResource(t, resources.NewNotificationSettingResource(), "sandbox", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/event-types" {
+					// Fall back to the event types embedded in the provider
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(`{"error":{"type":"api_error","code":"internal_error","detail":"boom"},"meta":{"request_id":"req_01"}}`))
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"data":{"id":"ntfset_01","description":"Webhook","type":"url","destination":"https://example.com","active":true,"api_version":1,"subscribed_events":[%s]},"meta":{"request_id":"req_01"}}`, strings.Join(events, ","))
+			}))
+
+			state, _ := testNotificationSettingValue(t, r, "subscription.*", "transaction.completed")
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+			identity := &tfsdk.ResourceIdentity{
+				Schema: identitySchemaResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+			}
+
+			resp := &fwresource.ReadResponse{State: state, Identity: identity}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var subscribedEvents customtypes.UnorderedList
+			resp.State.GetAttribute(ctx, path.Root("subscribed_events"), &subscribedEvents)
+			var got []string
+			subscribedEvents.ElementsAs(ctx, &got, false)
+			if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("expected subscribed_events %v but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// Returns the attributes of a notification setting in state, rotated from
+// ntfset_00 at rotationStartedAt when it is not empty. rotation_trigger is null
+// when trigger is empty.
+func testRotationState(trigger string, rotationStartedAt time.Time) map[string]tftypes.Value {
+	attributes := map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "ntfset_01"),
+		"description":         tftypes.NewValue(tftypes.String, "Webhook"),
+		"destination":         tftypes.NewValue(tftypes.String, "https://example.com/webhook"),
+		"endpoint_secret_key": tftypes.NewValue(tftypes.String, "pdl_ntfset_01_secret"),
+		"rotation_trigger":    tftypes.NewValue(tftypes.String, trigger),
+		"subscribed_events": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "transaction.completed"),
+		}),
+	}
+	if trigger == "" {
+		attributes["rotation_trigger"] = tftypes.NewValue(tftypes.String, nil)
+	}
+	if !rotationStartedAt.IsZero() {
+		attributes["previous_id"] = tftypes.NewValue(tftypes.String, "ntfset_00")
+		attributes["previous_endpoint_secret_key"] = tftypes.NewValue(tftypes.String, "pdl_ntfset_00_secret")
+		attributes["rotation_started_at"] = tftypes.NewValue(tftypes.String, rotationStartedAt.Format(time.RFC3339))
+	}
+	return attributes
+}
+
+func TestNotificationSettingResource_ModifyPlan_rotation(t *testing.T) {
+	tests := []struct {
+		name              string
+		priorTrigger      string
+		trigger           string
+		triggerUnknown    bool
+		rotationStartedAt time.Time
+		expectIDUnknown   bool
+		expectPreviousID  types.String
+		expectError       bool
+	}{
+		{
+			name:             "trigger unchanged",
+			priorTrigger:     "1",
+			trigger:          "1",
+			expectPreviousID: types.StringNull(),
+		},
+		{
+			name:             "trigger changed",
+			priorTrigger:     "1",
+			trigger:          "2",
+			expectIDUnknown:  true,
+			expectPreviousID: types.StringValue("ntfset_01"),
+		},
+		{
+			name:              "overlap not elapsed",
+			priorTrigger:      "1",
+			trigger:           "1",
+			rotationStartedAt: time.Now().Add(-time.Hour),
+			expectPreviousID:  types.StringValue("ntfset_00"),
+		},
+		{
+			name:              "overlap elapsed",
+			priorTrigger:      "1",
+			trigger:           "1",
+			rotationStartedAt: time.Now().Add(-48 * time.Hour),
+			expectPreviousID:  types.StringNull(),
+		},
+		{
+			name:              "trigger changed during overlap",
+			priorTrigger:      "1",
+			trigger:           "2",
+			rotationStartedAt: time.Now().Add(-time.Hour),
+			expectError:       true,
+		},
+		{
+			name:              "trigger changed after overlap",
+			priorTrigger:      "1",
+			trigger:           "2",
+			rotationStartedAt: time.Now().Add(-48 * time.Hour),
+			expectIDUnknown:   true,
+			expectPreviousID:  types.StringValue("ntfset_01"),
+		},
+		{
+			name:             "trigger removed",
+			priorTrigger:     "1",
+			expectPreviousID: types.StringNull(),
+		},
+		{
+			name:              "trigger removed during overlap",
+			priorTrigger:      "1",
+			rotationStartedAt: time.Now().Add(-time.Hour),
+			expectPreviousID:  types.StringValue("ntfset_00"),
+		},
+		{
+			name:             "trigger unknown",
+			priorTrigger:     "1",
+			triggerUnknown:   true,
+			expectIDUnknown:  true,
+			expectPreviousID: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := resources.NewNotificationSettingResource()
+
+			resourceSchema, stateRaw := testResourceRaw(t, r, testRotationState(tt.priorTrigger, tt.rotationStartedAt))
+			planAttributes := testRotationState(tt.trigger, tt.rotationStartedAt)
+			for _, name := range []string{"previous_id", "previous_endpoint_secret_key", "rotation_started_at"} {
+				planAttributes[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			}
+			if tt.triggerUnknown {
+				planAttributes["rotation_trigger"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			}
+			_, planRaw := testResourceRaw(t, r, planAttributes)
+
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: planRaw}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+				Plan:  plan,
+			}, resp)
+
+			if tt.expectError {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Rotation In Progress" {
+					t.Fatalf("expected a rotation in progress error but got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var id, previousID types.String
+			resp.Plan.GetAttribute(ctx, path.Root("id"), &id)
+			resp.Plan.GetAttribute(ctx, path.Root("previous_id"), &previousID)
+			if id.IsUnknown() != tt.expectIDUnknown {
+				t.Errorf("expected id unknown %t but got %s", tt.expectIDUnknown, id)
+			}
+			if !previousID.Equal(tt.expectPreviousID) {
+				t.Errorf("expected previous_id %s but got %s", tt.expectPreviousID, previousID)
+			}
+		})
+	}
+}
+
+func TestNotificationSettingResource_Update_rotation(t *testing.T) {
+	startedAt := time.Now().Add(-48 * time.Hour)
+	ongoingStartedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name             string
+		state            map[string]tftypes.Value
+		plan             map[string]tftypes.Value
+		expectRequests   []string
+		expectID         string
+		expectPreviousID string
+	}{
+		{
+			// Rotate again once the overlap of the previous rotation has elapsed
+			name:  "trigger changed after overlap",
+			state: testRotationState("1", startedAt),
+			plan: testRotationPlan(testRotationState("2", startedAt), map[string]tftypes.Value{
+				"previous_id":                  tftypes.NewValue(tftypes.String, "ntfset_01"),
+				"previous_endpoint_secret_key": tftypes.NewValue(tftypes.String, "pdl_ntfset_01_secret"),
+			}),
+			expectRequests:   []string{`PATCH /notification-settings/ntfset_00 {"active":false}`, "POST /notification-settings "},
+			expectID:         "ntfset_02",
+			expectPreviousID: "ntfset_01",
+		},
+		{
+			// The trigger was unknown when planning and resolved to its prior value
+			name:  "trigger unknown and unchanged",
+			state: testRotationState("1", ongoingStartedAt),
+			plan: testRotationPlan(testRotationState("1", ongoingStartedAt), map[string]tftypes.Value{
+				"previous_id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"previous_endpoint_secret_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expectRequests:   []string{"PATCH /notification-settings/ntfset_01 "},
+			expectID:         "ntfset_01",
+			expectPreviousID: "ntfset_00",
+		},
+		{
+			// Removing the trigger keeps the notification setting and its
+			// ongoing rotation
+			name:             "trigger removed",
+			state:            testRotationState("1", ongoingStartedAt),
+			plan:             testRotationState("", ongoingStartedAt),
+			expectRequests:   []string{"PATCH /notification-settings/ntfset_01 "},
+			expectID:         "ntfset_01",
+			expectPreviousID: "ntfset_00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requests []string
+			r := testConfigureResource(t, resources.NewNotificationSettingResource(), "sandbox", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/event-types" {
+					_, _ = w.Write([]byte(`{"data":[{"name":"transaction.completed","group":"Transaction"}],"meta":{"request_id":"req_01"}}`))
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				requests = append(requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body)))
+				id := strings.TrimPrefix(r.URL.Path, "/notification-settings/")
+				if r.Method == http.MethodPost {
+					id = "ntfset_02"
+				}
+				_, _ = fmt.Fprintf(w, `{"data":{"id":%q,"description":"Webhook","type":"url","destination":"https://example.com/webhook","active":true,"api_version":1,"endpoint_secret_key":"pdl_%s_secret","subscribed_events":[{"name":"transaction.completed"}]},"meta":{"request_id":"req_01"}}`, id, id)
+			}))
+
+			resourceSchema, stateRaw := testResourceRaw(t, r, tt.state)
+			_, planRaw := testResourceRaw(t, r, tt.plan)
+
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+			identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+			identity := &tfsdk.ResourceIdentity{
+				Schema: identitySchemaResp.IdentitySchema,
+				Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+					"id":          tftypes.NewValue(tftypes.String, "ntfset_01"),
+					"environment": tftypes.NewValue(tftypes.String, "sandbox"),
+				}),
+			}
+
+			resp := &fwresource.UpdateResponse{
+				State:    tfsdk.State{Schema: resourceSchema, Raw: planRaw},
+				Identity: &tfsdk.ResourceIdentity{Schema: identity.Schema, Raw: identity.Raw.Copy()},
+			}
+			r.Update(ctx, fwresource.UpdateRequest{
+				Plan:     tfsdk.Plan{Schema: resourceSchema, Raw: planRaw},
+				State:    tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+				Identity: identity,
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if len(requests) != len(tt.expectRequests) {
+				t.Fatalf("expected requests %v, got %v", tt.expectRequests, requests)
+			}
+			for i, expected := range tt.expectRequests {
+				if !strings.HasPrefix(requests[i], expected) {
+					t.Fatalf("expected requests %v, got %v", tt.expectRequests, requests)
+				}
+			}
+
+			var id, secret, previousID, previousSecret, rotationStartedAt, identityID types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			resp.State.GetAttribute(ctx, path.Root("endpoint_secret_key"), &secret)
+			resp.State.GetAttribute(ctx, path.Root("previous_id"), &previousID)
+			resp.State.GetAttribute(ctx, path.Root("previous_endpoint_secret_key"), &previousSecret)
+			resp.State.GetAttribute(ctx, path.Root("rotation_started_at"), &rotationStartedAt)
+			resp.Identity.GetAttribute(ctx, path.Root("id"), &identityID)
+
+			if id.ValueString() != tt.expectID || secret.ValueString() != "pdl_"+tt.expectID+"_secret" || identityID.ValueString() != tt.expectID {
+				t.Errorf("expected the notification setting %s, got %s with secret %s and identity %s", tt.expectID, id, secret, identityID)
+			}
+			if previousID.ValueString() != tt.expectPreviousID || previousSecret.ValueString() != "pdl_"+tt.expectPreviousID+"_secret" {
+				t.Errorf("expected the previous notification setting %s, got %s", tt.expectPreviousID, previousID)
+			}
+			if rotationStartedAt.IsNull() || rotationStartedAt.IsUnknown() {
+				t.Errorf("expected rotation_started_at to be set, got %s", rotationStartedAt)
+			}
+		})
+	}
+}
+
+// Returns the planned attributes of a notification setting being rotated, with
+// the new ID, endpoint secret and rotation start not known yet.
+func testRotationPlan(attributes map[string]tftypes.Value, overrides map[string]tftypes.Value) map[string]tftypes.Value {
+	for _, name := range []string{"id", "endpoint_secret_key", "rotation_started_at"} {
+		attributes[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	for name, value := range overrides {
+		attributes[name] = value
+	}
+	return attributes
+}
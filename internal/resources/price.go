@@ -2,12 +2,20 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
 	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,11 +25,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PriceResource{}
 var _ resource.ResourceWithImportState = &PriceResource{}
+var _ resource.ResourceWithUpgradeState = &PriceResource{}
+var _ resource.ResourceWithIdentity = &PriceResource{}
+var _ resource.ResourceWithModifyPlan = &PriceResource{}
+
+// Private state key holding the ID of the price being replaced.
+const replacedPriceIDPrivateKey = "replaced_price_id"
+
+// Number of subscriptions updated concurrently when migrating, unless configured.
+const defaultMigrationBatchSize = 10
 
 // Creates a new Paddle price resource.
 func NewPriceResource() resource.Resource {
@@ -30,30 +48,34 @@ func NewPriceResource() resource.Resource {
 
 // Price resource manages Paddle prices.
 type PriceResource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 // Price resource model describes the resource data model.
 type priceResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	ProductID          types.String `tfsdk:"product_id"`
-	Description        types.String `tfsdk:"description"`
-	Name               types.String `tfsdk:"name"`
-	TaxMode            types.String `tfsdk:"tax_mode"`
-	UnitPrice          types.Object `tfsdk:"unit_price"`
-	UnitPriceOverrides types.List   `tfsdk:"unit_price_overrides"`
-	BillingCycle       types.Object `tfsdk:"billing_cycle"`
-	TrialPeriod        types.Object `tfsdk:"trial_period"`
-	Quantity           types.Object `tfsdk:"quantity"`
-	CustomData         types.Map    `tfsdk:"custom_data"`
-	Status             types.String `tfsdk:"status"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
+	ID                    types.String   `tfsdk:"id"`
+	ProductID             types.String   `tfsdk:"product_id"`
+	Description           types.String   `tfsdk:"description"`
+	Name                  types.String   `tfsdk:"name"`
+	TaxMode               types.String   `tfsdk:"tax_mode"`
+	UnitPrice             types.Object   `tfsdk:"unit_price"`
+	UnitPriceOverrides    types.List     `tfsdk:"unit_price_overrides"`
+	BillingCycle          types.Object   `tfsdk:"billing_cycle"`
+	TrialPeriod           types.Object   `tfsdk:"trial_period"`
+	Quantity              types.Object   `tfsdk:"quantity"`
+	CustomData            types.Map      `tfsdk:"custom_data"`
+	FailIfSubscribed      types.Bool     `tfsdk:"fail_if_subscribed"`
+	SubscriptionMigration types.Object   `tfsdk:"subscription_migration"`
+	ReplacedPriceID       types.String   `tfsdk:"replaced_price_id"`
+	Status                types.String   `tfsdk:"status"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	UpdatedAt             types.String   `tfsdk:"updated_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 type unitPriceModel struct {
-	Amount       types.String `tfsdk:"amount"`
-	CurrencyCode types.String `tfsdk:"currency_code"`
+	Amount       customtypes.MoneyAmount `tfsdk:"amount"`
+	CurrencyCode types.String            `tfsdk:"currency_code"`
 }
 
 type billingCycleModel struct {
@@ -71,9 +93,15 @@ type quantityModel struct {
 	Maximum types.Int64 `tfsdk:"maximum"`
 }
 
+type subscriptionMigrationModel struct {
+	ProrationBillingMode types.String `tfsdk:"proration_billing_mode"`
+	BatchSize            types.Int64  `tfsdk:"batch_size"`
+	DryRun               types.Bool   `tfsdk:"dry_run"`
+}
+
 type unitPriceOverrideModel struct {
-	CountryCodes types.List   `tfsdk:"country_codes"`
-	UnitPrice    types.Object `tfsdk:"unit_price"`
+	CountryCodes customtypes.UnorderedList `tfsdk:"country_codes"`
+	UnitPrice    types.Object              `tfsdk:"unit_price"`
 }
 
 // Metadata returns the resource type name.
@@ -84,6 +112,7 @@ func (r *PriceResource) Metadata(ctx context.Context, req resource.MetadataReque
 // Schema returns the resource schema definition.
 func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Paddle price resource. Prices determine how much and how often you charge for a product.",
 
 		Attributes: map[string]schema.Attribute{
@@ -129,6 +158,7 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"amount": schema.StringAttribute{
 						Required:            true,
+						CustomType:          customtypes.MoneyAmountType{},
 						MarkdownDescription: "Amount in cents as a string (e.g., '2900' for $29.00).",
 					},
 					"currency_code": schema.StringAttribute{
@@ -148,6 +178,7 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"country_codes": schema.ListAttribute{
 							Required:            true,
 							ElementType:         types.StringType,
+							CustomType:          customtypes.NewUnorderedListType(types.StringType),
 							MarkdownDescription: "List of two-letter ISO 3166-1 alpha-2 country codes (e.g., ['GB', 'FR', 'DE']).",
 						},
 						"unit_price": schema.SingleNestedAttribute{
@@ -156,6 +187,7 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Attributes: map[string]schema.Attribute{
 								"amount": schema.StringAttribute{
 									Required:            true,
+									CustomType:          customtypes.MoneyAmountType{},
 									MarkdownDescription: "Amount in cents as a string.",
 								},
 								"currency_code": schema.StringAttribute{
@@ -224,6 +256,41 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				ElementType:         types.StringType,
 				MarkdownDescription: "Custom data for this price. Max 10 keys, 1KB total.",
 			},
+			"fail_if_subscribed": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail the plan instead of warning when this price is replaced or archived while active or trialing subscriptions still bill on it. When destroying, the value from state is used, so set it to `false` and apply before removing the resource.",
+			},
+			"subscription_migration": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Moves active and trialing subscriptions from the replaced price to the new one when this price is replaced, using the update subscription API.",
+				Attributes: map[string]schema.Attribute{
+					"proration_billing_mode": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "How Paddle bills the change on each subscription. One of: `prorated_immediately`, `prorated_next_billing_period`, `full_immediately`, `full_next_billing_period`, `do_not_bill`. Defaults to `prorated_next_billing_period`.",
+						Validators: []validator.String{
+							validators.ProrationBillingModeValidator{},
+						},
+					},
+					"batch_size": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of subscriptions updated concurrently. Must be at least 1. Defaults to `10`.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"dry_run": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Only report which subscriptions would be migrated, without updating them. Defaults to `false`.",
+					},
+				},
+			},
+			"replaced_price_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle ID of the price this one replaced, when it was created by a replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Status of the price. Either 'active' or 'archived'.",
@@ -246,20 +313,33 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+// IdentitySchema returns the resource identity schema definition.
+func (r *PriceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle price ID (format: pri_...)")
+}
+
 // Configure initializes the resource with the Paddle SDK client.
 func (r *PriceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -277,9 +357,17 @@ func (r *PriceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "price", "create", createTimeout)
+	defer done()
+
 	// Parse unit_price
 	var unitPrice unitPriceModel
-	diags := data.UnitPrice.As(ctx, &unitPrice, basetypes.ObjectAsOptions{})
+	diags = data.UnitPrice.As(ctx, &unitPrice, basetypes.ObjectAsOptions{})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -404,9 +492,12 @@ func (r *PriceResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create price via Paddle API
 	price, err := r.client.CreatePrice(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error creating price",
-			fmt.Sprintf("Could not create price for product %s (description: %s): %s", data.ProductID.ValueString(), data.Description.ValueString(), err.Error()),
+			fmt.Sprintf("Could not create price for product %s (description: %s)", data.ProductID.ValueString(), data.Description.ValueString()),
+			err,
 		)
 		return
 	}
@@ -437,8 +528,19 @@ func (r *PriceResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.Quantity = types.ObjectNull(quantityAttrTypes)
 	}
 
+	if data.ReplacedPriceID.IsUnknown() {
+		data.ReplacedPriceID = types.StringNull()
+	}
+
+	// Move subscriptions over from the replaced price if requested
+	r.migrateSubscriptions(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Read retrieves the current state of a Paddle price.
@@ -451,188 +553,41 @@ func (r *PriceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Read price from Paddle API
-	price, err := r.client.GetPrice(ctx, &paddle.GetPriceRequest{
-		PriceID: data.ID.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading price",
-			fmt.Sprintf("Could not read price ID %s: %s", data.ID.ValueString(), err.Error()),
-		)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Update model with response data
-	data.ProductID = types.StringValue(price.ProductID)
-	data.Description = types.StringValue(price.Description)
-	data.Status = types.StringValue(string(price.Status))
-	data.CreatedAt = types.StringValue(price.CreatedAt)
-	data.UpdatedAt = types.StringValue(price.UpdatedAt)
-
-	if price.Name != nil {
-		data.Name = types.StringValue(*price.Name)
-	} else {
-		data.Name = types.StringNull()
-	}
-
-	// Map tax_mode
-	data.TaxMode = types.StringValue(string(price.TaxMode))
-
-	// Map unit_price
-	unitPriceAttrTypes := map[string]attr.Type{
-		"amount":        types.StringType,
-		"currency_code": types.StringType,
-	}
-	unitPriceObj, diags := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
-		"amount":        types.StringValue(price.UnitPrice.Amount),
-		"currency_code": types.StringValue(string(price.UnitPrice.CurrencyCode)),
-	})
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.UnitPrice = unitPriceObj
-
-	// Map unit_price_overrides if present
-	if len(price.UnitPriceOverrides) > 0 {
-		overrideElements := []attr.Value{}
-		for _, override := range price.UnitPriceOverrides {
-			// Convert country codes to string list
-			countryCodeValues := make([]attr.Value, len(override.CountryCodes))
-			for i, code := range override.CountryCodes {
-				countryCodeValues[i] = types.StringValue(string(code))
-			}
-			countryCodesList, diags := types.ListValue(types.StringType, countryCodeValues)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-
-			// Create unit price object
-			overrideUnitPriceObj, diags := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
-				"amount":        types.StringValue(override.UnitPrice.Amount),
-				"currency_code": types.StringValue(string(override.UnitPrice.CurrencyCode)),
-			})
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-
-			// Create override object
-			overrideAttrTypes := map[string]attr.Type{
-				"country_codes": types.ListType{ElemType: types.StringType},
-				"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
-			}
-			overrideObj, diags := types.ObjectValue(overrideAttrTypes, map[string]attr.Value{
-				"country_codes": countryCodesList,
-				"unit_price":    overrideUnitPriceObj,
-			})
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-
-			overrideElements = append(overrideElements, overrideObj)
-		}
-
-		overrideAttrTypes := map[string]attr.Type{
-			"country_codes": types.ListType{ElemType: types.StringType},
-			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
-		}
-		overridesList, diags := types.ListValue(types.ObjectType{AttrTypes: overrideAttrTypes}, overrideElements)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.UnitPriceOverrides = overridesList
-	} else {
-		overrideAttrTypes := map[string]attr.Type{
-			"country_codes": types.ListType{ElemType: types.StringType},
-			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
-		}
-		data.UnitPriceOverrides = types.ListNull(types.ObjectType{AttrTypes: overrideAttrTypes})
-	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "price", "read", readTimeout)
+	defer done()
 
-	// Map billing_cycle if present
-	billingCycleAttrTypes := map[string]attr.Type{
-		"frequency": types.Int64Type,
-		"interval":  types.StringType,
-	}
-	if price.BillingCycle != nil {
-		billingCycleObj, diags := types.ObjectValue(billingCycleAttrTypes, map[string]attr.Value{
-			"frequency": types.Int64Value(int64(price.BillingCycle.Frequency)),
-			"interval":  types.StringValue(string(price.BillingCycle.Interval)),
-		})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.BillingCycle = billingCycleObj
-	} else {
-		data.BillingCycle = types.ObjectNull(billingCycleAttrTypes)
-	}
-
-	// Map trial_period if present
-	trialPeriodAttrTypes := map[string]attr.Type{
-		"frequency": types.Int64Type,
-		"interval":  types.StringType,
-	}
-	if price.TrialPeriod != nil {
-		trialPeriodObj, diags := types.ObjectValue(trialPeriodAttrTypes, map[string]attr.Value{
-			"frequency": types.Int64Value(int64(price.TrialPeriod.Frequency)),
-			"interval":  types.StringValue(string(price.TrialPeriod.Interval)),
-		})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.TrialPeriod = trialPeriodObj
-	} else {
-		data.TrialPeriod = types.ObjectNull(trialPeriodAttrTypes)
-	}
-
-	// Map quantity if present (check if not zero value)
-	quantityAttrTypes := map[string]attr.Type{
-		"minimum": types.Int64Type,
-		"maximum": types.Int64Type,
-	}
-	if price.Quantity.Minimum > 0 || price.Quantity.Maximum > 0 {
-		quantityObj, diags := types.ObjectValue(quantityAttrTypes, map[string]attr.Value{
-			"minimum": types.Int64Value(int64(price.Quantity.Minimum)),
-			"maximum": types.Int64Value(int64(price.Quantity.Maximum)),
-		})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Quantity = quantityObj
-	} else {
-		data.Quantity = types.ObjectNull(quantityAttrTypes)
+	// Read price from Paddle API
+	price, err := r.client.ReadPrice(ctx, data.ID.ValueString())
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error reading price",
+			fmt.Sprintf("Could not read price ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
 	}
 
-	// Map custom_data if present
-	if price.CustomData != nil {
-		customDataMap, err := helpers.CustomDataToMap(price.CustomData)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error processing custom data",
-				fmt.Sprintf("Could not convert custom_data for price %s: %s", data.ID.ValueString(), err.Error()),
-			)
-			return
-		}
-		customDataValue, diags := types.MapValue(types.StringType, customDataMap)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CustomData = customDataValue
-	} else {
-		data.CustomData = types.MapNull(types.StringType)
+	// Update model with response data
+	resp.Diagnostics.Append(priceToModel(ctx, price, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Update modifies an existing Paddle price.
@@ -648,6 +603,19 @@ func (r *PriceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "price", "update", updateTimeout)
+	defer done()
+
 	// Check if any mutable fields actually changed
 	hasChanges := !plan.Description.Equal(state.Description) ||
 		!plan.Name.Equal(state.Name) ||
@@ -660,12 +628,13 @@ func (r *PriceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		plan.UpdatedAt = state.UpdatedAt
 		plan.Status = state.Status
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, plan.ID))...)
 		return
 	}
 
 	// Parse unit_price
 	var unitPrice unitPriceModel
-	diags := plan.UnitPrice.As(ctx, &unitPrice, basetypes.ObjectAsOptions{})
+	diags = plan.UnitPrice.As(ctx, &unitPrice, basetypes.ObjectAsOptions{})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -720,10 +689,14 @@ func (r *PriceResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Update price via Paddle API
 	price, err := r.client.UpdatePrice(ctx, updateReq)
+	r.client.ForgetPrice(plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error updating price",
-			fmt.Sprintf("Could not update price ID %s (product: %s, description: %s): %s", plan.ID.ValueString(), plan.ProductID.ValueString(), plan.Description.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update price ID %s (product: %s, description: %s)", plan.ID.ValueString(), plan.ProductID.ValueString(), plan.Description.ValueString()),
+			err,
 		)
 		return
 	}
@@ -754,6 +727,7 @@ func (r *PriceResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, plan.ID))...)
 }
 
 // Delete archives a Paddle price by setting its status to archived.
@@ -767,6 +741,19 @@ func (r *PriceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "price", "delete", deleteTimeout)
+	defer done()
+
 	// Archive the price by setting status to "archived"
 	updateReq := &paddle.UpdatePriceRequest{
 		PriceID: data.ID.ValueString(),
@@ -774,16 +761,442 @@ func (r *PriceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	_, err := r.client.UpdatePrice(ctx, updateReq)
+	r.client.ForgetPrice(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error archiving price",
-			fmt.Sprintf("Could not archive price ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not archive price ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 }
 
-// ImportState imports an existing Paddle price by its ID.
+// ModifyPlan checks for active and trialing subscriptions on prices about to be replaced or archived.
+// Paddle keeps billing those subscriptions on the archived price, so the plan warns about them
+// (or fails when `fail_if_subscribed` is set), unless they are migrated to the replacement price.
+func (r *PriceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Creating a price, possibly as the second half of a replacement
+	if req.State.Raw.IsNull() {
+		r.planReplacedPriceID(ctx, req, resp)
+		return
+	}
+
+	var state priceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action := "archived"
+	failIfSubscribed := state.FailIfSubscribed.ValueBool()
+	var migration *subscriptionMigrationModel
+
+	// A non-null plan is either an in-place update or a replacement
+	if !req.Plan.Raw.IsNull() {
+		var plan priceResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !priceRequiresReplace(plan, state) {
+			return
+		}
+
+		// Remember the price being replaced so the replacement can migrate its subscriptions
+		replacedPriceID, err := json.Marshal(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error planning price replacement", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, replacedPriceIDPrivateKey, replacedPriceID)...)
+
+		action = "replaced"
+		failIfSubscribed = plan.FailIfSubscribed.ValueBool()
+		var diags diag.Diagnostics
+		migration, diags = subscriptionMigrationFromObject(ctx, plan.SubscriptionMigration)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Nothing to check before the provider is configured
+	if r.client == nil {
+		return
+	}
+
+	count, sampleIDs, err := countPriceSubscriptions(ctx, r.client.SDK, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check subscriptions for price",
+			fmt.Sprintf("Could not list subscriptions for price ID %s before it is %s: %s", state.ID.ValueString(), action, err.Error()),
+		)
+		return
+	}
+
+	if count == 0 {
+		return
+	}
+
+	if migration != nil && !migration.DryRun.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Price subscriptions will be migrated",
+			fmt.Sprintf(
+				"Price ID %s will be replaced, and its %d active or trialing subscription(s) (for example: %s) will be moved to the new price using the %s proration billing mode.",
+				state.ID.ValueString(), count, strings.Join(sampleIDs, ", "), migration.ProrationBillingMode.ValueString(),
+			),
+		)
+		return
+	}
+
+	summary := "Price has live subscriptions"
+	detail := fmt.Sprintf(
+		"Price ID %s will be %s, but %d active or trialing subscription(s) still bill on it (for example: %s). "+
+			"Existing subscriptions keep billing on the archived price until they are moved to another price.",
+		state.ID.ValueString(), action, count, strings.Join(sampleIDs, ", "),
+	)
+
+	if failIfSubscribed {
+		resp.Diagnostics.AddError(summary, detail+" Set fail_if_subscribed to false to allow this change.")
+	} else {
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+}
+
+// Fills in `replaced_price_id` when planning the creation half of a replacement.
+// Terraform hands the private data planned for the replaced instance to this second plan.
+func (r *PriceResource) planReplacedPriceID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	replacedPriceID := types.StringNull()
+
+	value, diags := req.Private.GetKey(ctx, replacedPriceIDPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if value != nil {
+		var id string
+		if err := json.Unmarshal(value, &id); err != nil {
+			resp.Diagnostics.AddError("Error planning price replacement", err.Error())
+			return
+		}
+		replacedPriceID = types.StringValue(id)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replaced_price_id"), replacedPriceID)...)
+}
+
+// Moves the subscriptions of the replaced price to the newly created one, or reports
+// what would be moved in dry-run mode. Failures are reported as warnings so the new
+// price is kept in state.
+func (r *PriceResource) migrateSubscriptions(ctx context.Context, data priceResourceModel, diags *diag.Diagnostics) {
+	if data.ReplacedPriceID.IsNull() || data.ReplacedPriceID.IsUnknown() {
+		return
+	}
+
+	migration, d := subscriptionMigrationFromObject(ctx, data.SubscriptionMigration)
+	diags.Append(d...)
+	if diags.HasError() || migration == nil {
+		return
+	}
+
+	fromPriceID := data.ReplacedPriceID.ValueString()
+	toPriceID := data.ID.ValueString()
+
+	if migration.DryRun.ValueBool() {
+		count, sampleIDs, err := countPriceSubscriptions(ctx, r.client.SDK, fromPriceID)
+		if err != nil {
+			diags.AddWarning(
+				"Unable to list subscriptions to migrate",
+				fmt.Sprintf("Could not list subscriptions for replaced price ID %s: %s", fromPriceID, err.Error()),
+			)
+			return
+		}
+		diags.AddWarning(
+			"Subscription migration dry run",
+			fmt.Sprintf(
+				"%d active or trialing subscription(s) would be moved from price ID %s to price ID %s (for example: %s). Set dry_run to false to migrate them.",
+				count, fromPriceID, toPriceID, strings.Join(sampleIDs, ", "),
+			),
+		)
+		return
+	}
+
+	result, err := migratePriceSubscriptions(
+		ctx,
+		r.client.SDK,
+		fromPriceID,
+		toPriceID,
+		paddle.ProrationBillingMode(migration.ProrationBillingMode.ValueString()),
+		int(migration.BatchSize.ValueInt64()),
+	)
+	if err != nil && result == nil {
+		diags.AddWarning(
+			"Unable to list subscriptions to migrate",
+			fmt.Sprintf("Could not list subscriptions for replaced price ID %s: %s", fromPriceID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Migrated price subscriptions", map[string]any{
+		"from_price_id": fromPriceID,
+		"to_price_id":   toPriceID,
+		"migrated":      len(result.Migrated),
+		"failed":        len(result.Failed),
+	})
+
+	if err != nil {
+		diags.AddWarning("Subscription migration interrupted", err.Error())
+	}
+
+	if len(result.Failed) > 0 {
+		failures := make([]string, 0, len(result.Failed))
+		for id, failure := range result.Failed {
+			failures = append(failures, fmt.Sprintf("%s: %s", id, failure.Error()))
+		}
+		sort.Strings(failures)
+		diags.AddWarning(
+			"Some subscriptions were not migrated",
+			fmt.Sprintf(
+				"Moved %d subscription(s) from price ID %s to price ID %s, but %d failed and still bill on the replaced price:\n%s",
+				len(result.Migrated), fromPriceID, toPriceID, len(result.Failed), strings.Join(failures, "\n"),
+			),
+		)
+	}
+}
+
+// Returns whether the planned changes touch attributes that force a new price.
+// Unknown planned values are treated as changes.
+func priceRequiresReplace(plan, state priceResourceModel) bool {
+	return !plan.ProductID.Equal(state.ProductID) ||
+		!plan.UnitPrice.Equal(state.UnitPrice) ||
+		!plan.BillingCycle.Equal(state.BillingCycle) ||
+		!plan.TrialPeriod.Equal(state.TrialPeriod)
+}
+
+// Reads the `subscription_migration` block, filling in defaults for omitted settings.
+// Returns nil when migration is not configured.
+func subscriptionMigrationFromObject(ctx context.Context, obj types.Object) (*subscriptionMigrationModel, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var migration subscriptionMigrationModel
+	diags := obj.As(ctx, &migration, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if migration.ProrationBillingMode.IsNull() || migration.ProrationBillingMode.IsUnknown() {
+		migration.ProrationBillingMode = types.StringValue(string(paddle.ProrationBillingModeProratedNextBillingPeriod))
+	}
+	if migration.BatchSize.IsNull() || migration.BatchSize.IsUnknown() {
+		migration.BatchSize = types.Int64Value(defaultMigrationBatchSize)
+	}
+
+	return &migration, diags
+}
+
+// Copies the attributes of a Paddle price into the resource model.
+func priceToModel(ctx context.Context, price *paddle.Price, data *priceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(price.ID)
+	data.ProductID = types.StringValue(price.ProductID)
+	data.Description = types.StringValue(price.Description)
+	data.Status = types.StringValue(string(price.Status))
+	data.CreatedAt = types.StringValue(price.CreatedAt)
+	data.UpdatedAt = types.StringValue(price.UpdatedAt)
+
+	if price.Name != nil {
+		data.Name = types.StringValue(*price.Name)
+	} else {
+		data.Name = types.StringNull()
+	}
+
+	// Map tax_mode
+	data.TaxMode = types.StringValue(string(price.TaxMode))
+
+	// Map unit_price
+	unitPriceAttrTypes := map[string]attr.Type{
+		"amount":        customtypes.MoneyAmountType{},
+		"currency_code": types.StringType,
+	}
+	unitPriceObj, d := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
+		"amount":        customtypes.NewMoneyAmountValue(price.UnitPrice.Amount),
+		"currency_code": types.StringValue(string(price.UnitPrice.CurrencyCode)),
+	})
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.UnitPrice = unitPriceObj
+
+	// Map unit_price_overrides if present
+	if len(price.UnitPriceOverrides) > 0 {
+		overrideElements := []attr.Value{}
+		for _, override := range price.UnitPriceOverrides {
+			// Convert country codes to string list
+			countryCodeValues := make([]attr.Value, len(override.CountryCodes))
+			for i, code := range override.CountryCodes {
+				countryCodeValues[i] = types.StringValue(string(code))
+			}
+			countryCodesList, d := customtypes.NewUnorderedListValue(types.StringType, countryCodeValues)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Create unit price object
+			overrideUnitPriceObj, d := types.ObjectValue(unitPriceAttrTypes, map[string]attr.Value{
+				"amount":        customtypes.NewMoneyAmountValue(override.UnitPrice.Amount),
+				"currency_code": types.StringValue(string(override.UnitPrice.CurrencyCode)),
+			})
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Create override object
+			overrideAttrTypes := map[string]attr.Type{
+				"country_codes": customtypes.NewUnorderedListType(types.StringType),
+				"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
+			}
+			overrideObj, d := types.ObjectValue(overrideAttrTypes, map[string]attr.Value{
+				"country_codes": countryCodesList,
+				"unit_price":    overrideUnitPriceObj,
+			})
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			overrideElements = append(overrideElements, overrideObj)
+		}
+
+		overrideAttrTypes := map[string]attr.Type{
+			"country_codes": customtypes.NewUnorderedListType(types.StringType),
+			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
+		}
+		overridesList, d := types.ListValue(types.ObjectType{AttrTypes: overrideAttrTypes}, overrideElements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.UnitPriceOverrides = overridesList
+	} else {
+		overrideAttrTypes := map[string]attr.Type{
+			"country_codes": customtypes.NewUnorderedListType(types.StringType),
+			"unit_price":    types.ObjectType{AttrTypes: unitPriceAttrTypes},
+		}
+		data.UnitPriceOverrides = types.ListNull(types.ObjectType{AttrTypes: overrideAttrTypes})
+	}
+
+	// Map billing_cycle if present
+	billingCycleAttrTypes := map[string]attr.Type{
+		"frequency": types.Int64Type,
+		"interval":  types.StringType,
+	}
+	if price.BillingCycle != nil {
+		billingCycleObj, d := types.ObjectValue(billingCycleAttrTypes, map[string]attr.Value{
+			"frequency": types.Int64Value(int64(price.BillingCycle.Frequency)),
+			"interval":  types.StringValue(string(price.BillingCycle.Interval)),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.BillingCycle = billingCycleObj
+	} else {
+		data.BillingCycle = types.ObjectNull(billingCycleAttrTypes)
+	}
+
+	// Map trial_period if present
+	trialPeriodAttrTypes := map[string]attr.Type{
+		"frequency": types.Int64Type,
+		"interval":  types.StringType,
+	}
+	if price.TrialPeriod != nil {
+		trialPeriodObj, d := types.ObjectValue(trialPeriodAttrTypes, map[string]attr.Value{
+			"frequency": types.Int64Value(int64(price.TrialPeriod.Frequency)),
+			"interval":  types.StringValue(string(price.TrialPeriod.Interval)),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.TrialPeriod = trialPeriodObj
+	} else {
+		data.TrialPeriod = types.ObjectNull(trialPeriodAttrTypes)
+	}
+
+	// Map quantity if present (check if not zero value)
+	quantityAttrTypes := map[string]attr.Type{
+		"minimum": types.Int64Type,
+		"maximum": types.Int64Type,
+	}
+	if price.Quantity.Minimum > 0 || price.Quantity.Maximum > 0 {
+		quantityObj, d := types.ObjectValue(quantityAttrTypes, map[string]attr.Value{
+			"minimum": types.Int64Value(int64(price.Quantity.Minimum)),
+			"maximum": types.Int64Value(int64(price.Quantity.Maximum)),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.Quantity = quantityObj
+	} else {
+		data.Quantity = types.ObjectNull(quantityAttrTypes)
+	}
+
+	// Map custom_data if present
+	if price.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(price.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for price %s: %s", price.ID, err.Error()),
+			)
+			return diags
+		}
+		customDataValue, d := types.MapValue(types.StringType, customDataMap)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CustomData = customDataValue
+	} else {
+		data.CustomData = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
+
+// ImportState imports an existing Paddle price by its ID or identity.
 func (r *PriceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// State migrations, indexed by the schema version they upgrade from.
+var priceStateMigrations = []stateMigration{
+	// 0 -> 1: unit price amounts and override country codes gained semantic-equality types.
+	unchangedState,
+}
+
+// UpgradeState migrates state from earlier schema versions.
+func (r *PriceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(ctx, r, priceStateMigrations)
 }
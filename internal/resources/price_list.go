@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &PriceListResource{}
+var _ list.ListResourceWithConfigure = &PriceListResource{}
+
+// Creates a new Paddle price list resource.
+func NewPriceListResource() list.ListResource {
+	return &PriceListResource{}
+}
+
+// Price list resource finds Paddle prices for `terraform query`.
+type PriceListResource struct {
+	client *paddleclient.Client
+}
+
+// Price list resource model describes the filters of a list block.
+type priceListModel struct {
+	ID        types.List   `tfsdk:"id"`
+	ProductID types.List   `tfsdk:"product_id"`
+	Status    types.List   `tfsdk:"status"`
+	Recurring types.Bool   `tfsdk:"recurring"`
+	Type      types.String `tfsdk:"type"`
+	OrderBy   types.String `tfsdk:"order_by"`
+}
+
+// Metadata returns the resource type name.
+func (r *PriceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price"
+}
+
+// ListResourceConfigSchema returns the filters supported by the list block.
+func (r *PriceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Paddle prices, using the filters of the list prices endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only the prices with these IDs.",
+			},
+			"product_id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only prices of these products.",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only prices with these statuses: 'active' or 'archived'.",
+			},
+			"recurring": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only recurring prices (`true`) or one-time prices (`false`).",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only prices of this type: 'standard' or 'custom'.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Order prices by a field and direction, for example `product_id[ASC]`.",
+			},
+		},
+	}
+}
+
+// Configure initializes the list resource with the Paddle SDK client.
+func (r *PriceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+// List streams the Paddle prices matching the filters.
+func (r *PriceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config priceListModel
+
+	diags := req.Config.Get(ctx, &config)
+	listReq := &paddle.ListPricesRequest{
+		ID:        stringsFilter(ctx, config.ID, &diags),
+		ProductID: stringsFilter(ctx, config.ProductID, &diags),
+		Status:    stringsFilter(ctx, config.Status, &diags),
+		Recurring: boolFilter(config.Recurring),
+		Type:      stringFilter(config.Type),
+		OrderBy:   stringFilter(config.OrderBy),
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, "prices",
+		func(ctx context.Context, perPage int) (*paddle.Collection[*paddle.Price], error) {
+			listReq.PerPage = &perPage
+			return r.client.ListPrices(ctx, listReq)
+		},
+		func(price *paddle.Price) (string, string) {
+			if price.Name != nil {
+				return price.ID, *price.Name
+			}
+			return price.ID, price.Description
+		},
+		priceToModel,
+	)
+}
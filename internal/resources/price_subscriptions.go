@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Maximum number of subscription IDs reported in plan diagnostics.
+const maxSampleSubscriptionIDs = 5
+
+// Subscription statuses that keep billing against a price.
+var billingSubscriptionStatuses = []string{
+	string(paddle.SubscriptionStatusActive),
+	string(paddle.SubscriptionStatusTrialing),
+}
+
+// Calls fn for every active and trialing subscription billing on the given price.
+func iterPriceSubscriptions(ctx context.Context, client *paddle.SDK, priceID string, fn func(s *paddle.Subscription)) error {
+	perPage := 200
+	subscriptions, err := client.ListSubscriptions(ctx, &paddle.ListSubscriptionsRequest{
+		PriceID: []string{priceID},
+		Status:  billingSubscriptionStatuses,
+		PerPage: &perPage,
+	})
+	if err != nil {
+		return err
+	}
+
+	return subscriptions.Iter(ctx, func(s *paddle.Subscription) (bool, error) {
+		fn(s)
+		return true, nil
+	})
+}
+
+// Counts the active and trialing subscriptions billing on the given price,
+// returning the total along with a few sample subscription IDs.
+func countPriceSubscriptions(ctx context.Context, client *paddle.SDK, priceID string) (int, []string, error) {
+	count := 0
+	samples := []string{}
+	err := iterPriceSubscriptions(ctx, client, priceID, func(s *paddle.Subscription) {
+		count++
+		if len(samples) < maxSampleSubscriptionIDs {
+			samples = append(samples, s.ID)
+		}
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count, samples, nil
+}
+
+// Result of moving subscriptions from one price to another.
+type subscriptionMigrationResult struct {
+	Migrated []string
+	Failed   map[string]error
+}
+
+// Moves every active and trialing subscription from one price to another.
+// Subscriptions are updated in batches of batchSize concurrent requests, and
+// a failure on one subscription does not stop the others from migrating.
+func migratePriceSubscriptions(ctx context.Context, client *paddle.SDK, fromPriceID, toPriceID string, mode paddle.ProrationBillingMode, batchSize int) (*subscriptionMigrationResult, error) {
+	// Collect subscriptions first, as updating them while paginating would shift the pages
+	var subscriptions []*paddle.Subscription
+	err := iterPriceSubscriptions(ctx, client, fromPriceID, func(s *paddle.Subscription) {
+		subscriptions = append(subscriptions, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	result := &subscriptionMigrationResult{
+		Migrated: []string{},
+		Failed:   map[string]error{},
+	}
+
+	var mu sync.Mutex
+	for start := 0; start < len(subscriptions); start += batchSize {
+		end := min(start+batchSize, len(subscriptions))
+
+		var wg sync.WaitGroup
+		for _, subscription := range subscriptions[start:end] {
+			wg.Add(1)
+			go func(s *paddle.Subscription) {
+				defer wg.Done()
+
+				_, err := client.UpdateSubscription(ctx, &paddle.UpdateSubscriptionRequest{
+					SubscriptionID:       s.ID,
+					Items:                paddle.NewPatchField(replaceSubscriptionPrice(s.Items, fromPriceID, toPriceID)),
+					ProrationBillingMode: paddle.NewPatchField(mode),
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					result.Failed[s.ID] = err
+				} else {
+					result.Migrated = append(result.Migrated, s.ID)
+				}
+			}(subscription)
+		}
+		wg.Wait()
+
+		if ctx.Err() != nil {
+			return result, fmt.Errorf("subscription migration interrupted after %d of %d subscriptions: %w", end, len(subscriptions), ctx.Err())
+		}
+	}
+
+	return result, nil
+}
+
+// Builds the full item list for a subscription update, swapping one price for another
+// and keeping every other item and all quantities unchanged.
+func replaceSubscriptionPrice(items []paddle.SubscriptionItem, fromPriceID, toPriceID string) []paddle.UpdateSubscriptionItems {
+	updated := make([]paddle.UpdateSubscriptionItems, 0, len(items))
+	for _, item := range items {
+		priceID := item.Price.ID
+		if priceID == fromPriceID {
+			priceID = toPriceID
+		}
+		updated = append(updated, paddle.UpdateSubscriptionItems{
+			SubscriptionUpdateItemFromCatalog: &paddle.SubscriptionUpdateItemFromCatalog{
+				PriceID:  priceID,
+				Quantity: item.Quantity,
+			},
+		})
+	}
+	return updated
+}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -82,6 +83,55 @@ func TestAccPriceResource_withQuantity(t *testing.T) {
 	})
 }
 
+func TestAccPriceResource_failIfSubscribed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPriceDestroy,
+		Steps: []resource.TestStep{
+			// A price without subscriptions can be replaced and archived even when guarded
+			{
+				Config: testAccPriceResourceConfigFailIfSubscribed("2900"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_price.test", "fail_if_subscribed", "true"),
+					resource.TestCheckResourceAttr("paddle_price.test", "unit_price.amount", "2900"),
+				),
+			},
+			{
+				Config: testAccPriceResourceConfigFailIfSubscribed("3900"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_price.test", "unit_price.amount", "3900"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPriceResource_subscriptionMigration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPriceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriceResourceConfigSubscriptionMigration("2900"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_price.test", "subscription_migration.dry_run", "true"),
+					resource.TestCheckNoResourceAttr("paddle_price.test", "replaced_price_id"),
+				),
+			},
+			// Replacing the price records the price it replaced
+			{
+				Config: testAccPriceResourceConfigSubscriptionMigration("3900"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_price.test", "unit_price.amount", "3900"),
+					resource.TestCheckResourceAttrSet("paddle_price.test", "replaced_price_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPriceResourceConfig() string {
 	return `
 resource "paddle_product" "test" {
@@ -175,6 +225,55 @@ resource "paddle_price" "test" {
 `
 }
 
+func testAccPriceResourceConfigFailIfSubscribed(amount string) string {
+	return fmt.Sprintf(`
+resource "paddle_product" "test" {
+  name         = "Test Product for Guarded Price"
+  tax_category = "saas"
+}
+
+resource "paddle_price" "test" {
+  product_id         = paddle_product.test.id
+  description        = "Guarded price"
+  fail_if_subscribed = true
+
+  unit_price = {
+    amount        = %q
+    currency_code = "USD"
+  }
+}
+`, amount)
+}
+
+func testAccPriceResourceConfigSubscriptionMigration(amount string) string {
+	return fmt.Sprintf(`
+resource "paddle_product" "test" {
+  name         = "Test Product for Migrated Price"
+  tax_category = "saas"
+}
+
+resource "paddle_price" "test" {
+  product_id  = paddle_product.test.id
+  description = "Migrated price"
+
+  unit_price = {
+    amount        = %q
+    currency_code = "USD"
+  }
+
+  billing_cycle = {
+    frequency = 1
+    interval  = "month"
+  }
+
+  subscription_migration = {
+    proration_billing_mode = "do_not_bill"
+    dry_run                = true
+  }
+}
+`, amount)
+}
+
 func testAccCheckPriceDestroy(s *terraform.State) error {
 	// Prices are archived, not deleted
 	for _, rs := range s.RootModule().Resources {
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
 	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
 	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +22,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProductResource{}
 var _ resource.ResourceWithImportState = &ProductResource{}
+var _ resource.ResourceWithUpgradeState = &ProductResource{}
+var _ resource.ResourceWithIdentity = &ProductResource{}
 
 // Creates a new Paddle product resource.
 func NewProductResource() resource.Resource {
@@ -27,20 +32,21 @@ func NewProductResource() resource.Resource {
 
 // Product resource manages Paddle products.
 type ProductResource struct {
-	client *paddle.SDK
+	client *paddleclient.Client
 }
 
 // Product resource model describes the resource data model.
 type productResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	TaxCategory types.String `tfsdk:"tax_category"`
-	ImageURL    types.String `tfsdk:"image_url"`
-	CustomData  types.Map    `tfsdk:"custom_data"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	TaxCategory types.String   `tfsdk:"tax_category"`
+	ImageURL    types.String   `tfsdk:"image_url"`
+	CustomData  types.Map      `tfsdk:"custom_data"`
+	Status      types.String   `tfsdk:"status"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -51,6 +57,7 @@ func (r *ProductResource) Metadata(ctx context.Context, req resource.MetadataReq
 // Schema returns the resource schema definition.
 func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Paddle product resource. Products are the items that you sell. Prices determine how much you charge for them.",
 
 		Attributes: map[string]schema.Attribute{
@@ -104,9 +111,22 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "RFC 3339 timestamp when the product was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+// IdentitySchema returns the resource identity schema definition.
+func (r *ProductResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle product ID (format: pro_...)")
+}
+
 // Configure initializes the resource with the Paddle SDK client.
 func (r *ProductResource) Configure(
 	ctx context.Context,
@@ -117,11 +137,11 @@ func (r *ProductResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*paddle.SDK)
+	client, ok := req.ProviderData.(*paddleclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *paddle.SDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -139,6 +159,14 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "product", "create", createTimeout)
+	defer done()
+
 	// Convert custom_data from types.Map to map[string]string
 	var customData map[string]string
 	if !data.CustomData.IsNull() && !data.CustomData.IsUnknown() {
@@ -173,9 +201,12 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create product via Paddle API
 	product, err := r.client.CreateProduct(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error creating product",
-			fmt.Sprintf("Could not create product with name '%s': %s", data.Name.ValueString(), err.Error()),
+			fmt.Sprintf("Could not create product with name '%s'", data.Name.ValueString()),
+			err,
 		)
 		return
 	}
@@ -188,6 +219,7 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Read retrieves the current state of a Paddle product.
@@ -200,58 +232,41 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "product", "read", readTimeout)
+	defer done()
+
 	// Read product from Paddle API
-	product, err := r.client.GetProduct(ctx, &paddle.GetProductRequest{
-		ProductID: data.ID.ValueString(),
-	})
+	product, err := r.client.ReadProduct(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error reading product",
-			fmt.Sprintf("Could not read product ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read product ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 
 	// Update model with response data
-	data.Name = types.StringValue(product.Name)
-	data.TaxCategory = types.StringValue(string(product.TaxCategory))
-	data.Status = types.StringValue(string(product.Status))
-	data.CreatedAt = types.StringValue(product.CreatedAt)
-	data.UpdatedAt = types.StringValue(product.UpdatedAt)
-
-	if product.Description != nil {
-		data.Description = types.StringValue(*product.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-
-	if product.ImageURL != nil {
-		data.ImageURL = types.StringValue(*product.ImageURL)
-	} else {
-		data.ImageURL = types.StringNull()
-	}
-
-	if product.CustomData != nil {
-		customDataMap, err := helpers.CustomDataToMap(product.CustomData)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error processing custom data",
-				fmt.Sprintf("Could not convert custom_data for product %s: %s", data.ID.ValueString(), err.Error()),
-			)
-			return
-		}
-		customDataValue, diags := types.MapValue(types.StringType, customDataMap)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CustomData = customDataValue
-	} else {
-		data.CustomData = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(productToModel(ctx, product, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Update modifies an existing Paddle product.
@@ -264,6 +279,19 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "product", "update", updateTimeout)
+	defer done()
+
 	// Convert custom_data from types.Map to map[string]string
 	var customData map[string]string
 	if !data.CustomData.IsNull() && !data.CustomData.IsUnknown() {
@@ -304,10 +332,14 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update product via Paddle API
 	product, err := r.client.UpdateProduct(ctx, updateReq)
+	r.client.ForgetProduct(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
 			"Error updating product",
-			fmt.Sprintf("Could not update product ID %s (name: %s): %s", data.ID.ValueString(), data.Name.ValueString(), err.Error()),
+			fmt.Sprintf("Could not update product ID %s (name: %s)", data.ID.ValueString(), data.Name.ValueString()),
+			err,
 		)
 		return
 	}
@@ -318,6 +350,7 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
 }
 
 // Delete archives a Paddle product by setting its status to archived.
@@ -331,6 +364,19 @@ func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "product", "delete", deleteTimeout)
+	defer done()
+
 	// Archive the product by setting its status to "archived"
 	updateReq := &paddle.UpdateProductRequest{
 		ProductID: data.ID.ValueString(),
@@ -338,16 +384,78 @@ func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	_, err := r.client.UpdateProduct(ctx, updateReq)
+	r.client.ForgetProduct(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
 			"Error archiving product",
-			fmt.Sprintf("Could not archive product ID %s: %s", data.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not archive product ID %s", data.ID.ValueString()),
+			err,
 		)
 		return
 	}
 }
 
-// ImportState imports an existing Paddle product by its ID.
+// Copies the attributes of a Paddle product into the resource model.
+func productToModel(ctx context.Context, product *paddle.Product, data *productResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(product.ID)
+	data.Name = types.StringValue(product.Name)
+	data.TaxCategory = types.StringValue(string(product.TaxCategory))
+	data.Status = types.StringValue(string(product.Status))
+	data.CreatedAt = types.StringValue(product.CreatedAt)
+	data.UpdatedAt = types.StringValue(product.UpdatedAt)
+
+	if product.Description != nil {
+		data.Description = types.StringValue(*product.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if product.ImageURL != nil {
+		data.ImageURL = types.StringValue(*product.ImageURL)
+	} else {
+		data.ImageURL = types.StringNull()
+	}
+
+	if product.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(product.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for product %s: %s", product.ID, err.Error()),
+			)
+			return diags
+		}
+		customDataValue, d := types.MapValue(types.StringType, customDataMap)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.CustomData = customDataValue
+	} else {
+		data.CustomData = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
+
+// ImportState imports an existing Paddle product by its ID or identity.
 func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// State migrations, indexed by the schema version they upgrade from.
+var productStateMigrations = []stateMigration{}
+
+// UpgradeState migrates state from earlier schema versions.
+func (r *ProductResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(ctx, r, productStateMigrations)
 }
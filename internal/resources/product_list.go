@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ProductListResource{}
+var _ list.ListResourceWithConfigure = &ProductListResource{}
+
+// Creates a new Paddle product list resource.
+func NewProductListResource() list.ListResource {
+	return &ProductListResource{}
+}
+
+// Product list resource finds Paddle products for `terraform query`.
+type ProductListResource struct {
+	client *paddleclient.Client
+}
+
+// Product list resource model describes the filters of a list block.
+type productListModel struct {
+	ID          types.List   `tfsdk:"id"`
+	Status      types.List   `tfsdk:"status"`
+	TaxCategory types.List   `tfsdk:"tax_category"`
+	Type        types.String `tfsdk:"type"`
+	OrderBy     types.String `tfsdk:"order_by"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProductListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+// ListResourceConfigSchema returns the filters supported by the list block.
+func (r *ProductListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Paddle products, using the filters of the list products endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only the products with these IDs.",
+			},
+			"status": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only products with these statuses: 'active' or 'archived'.",
+			},
+			"tax_category": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Return only products with these tax categories.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return only products of this type: 'standard' or 'custom'.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Order products by a field and direction, for example `created_at[DESC]`.",
+			},
+		},
+	}
+}
+
+// Configure initializes the list resource with the Paddle SDK client.
+func (r *ProductListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+// List streams the Paddle products matching the filters.
+func (r *ProductListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config productListModel
+
+	diags := req.Config.Get(ctx, &config)
+	listReq := &paddle.ListProductsRequest{
+		ID:          stringsFilter(ctx, config.ID, &diags),
+		Status:      stringsFilter(ctx, config.Status, &diags),
+		TaxCategory: stringsFilter(ctx, config.TaxCategory, &diags),
+		Type:        stringFilter(config.Type),
+		OrderBy:     stringFilter(config.OrderBy),
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, "products",
+		func(ctx context.Context, perPage int) (*paddle.Collection[*paddle.Product], error) {
+			listReq.PerPage = &perPage
+			return r.client.ListProducts(ctx, listReq)
+		},
+		func(product *paddle.Product) (string, string) {
+			return product.ID, product.Name
+		},
+		productToModel,
+	)
+}
@@ -0,0 +1,864 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/PaddleHQ/paddle-go-sdk/v4/pkg/paddlenotification"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SimulationResource{}
+var _ resource.ResourceWithImportState = &SimulationResource{}
+var _ resource.ResourceWithIdentity = &SimulationResource{}
+var _ resource.ResourceWithModifyPlan = &SimulationResource{}
+var _ resource.ResourceWithValidateConfig = &SimulationResource{}
+
+// Interval between reads of a simulation run while Paddle sends its events.
+var simulationRunPollInterval = 2 * time.Second
+
+// Scenarios Paddle can simulate, in addition to single event types.
+var simulationScenarioTypes = []paddle.SimulationScenarioType{
+	paddle.SimulationScenarioTypeSubscriptionCreation,
+	paddle.SimulationScenarioTypeSubscriptionRenewal,
+	paddle.SimulationScenarioTypeSubscriptionPause,
+	paddle.SimulationScenarioTypeSubscriptionResume,
+	paddle.SimulationScenarioTypeSubscriptionCancellation,
+}
+
+// Creates a new Paddle simulation resource.
+func NewSimulationResource() resource.Resource {
+	return &SimulationResource{}
+}
+
+// Manages Paddle simulations, which send synthetic events to a notification setting.
+type SimulationResource struct {
+	client *paddleclient.Client
+}
+
+// simulationResourceModel describes the resource data model.
+type simulationResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	NotificationSettingID types.String   `tfsdk:"notification_setting_id"`
+	Name                  types.String   `tfsdk:"name"`
+	Type                  types.String   `tfsdk:"type"`
+	Payload               types.String   `tfsdk:"payload"`
+	Config                types.String   `tfsdk:"config"`
+	RunTrigger            types.String   `tfsdk:"run_trigger"`
+	RequireDelivery       types.Bool     `tfsdk:"require_delivery"`
+	Status                types.String   `tfsdk:"status"`
+	LastRunAt             types.String   `tfsdk:"last_run_at"`
+	LastRun               types.Object   `tfsdk:"last_run"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// simulationRunModel describes a run of a simulation.
+type simulationRunModel struct {
+	ID        types.String `tfsdk:"id"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Events    types.List   `tfsdk:"events"`
+}
+
+// simulationEventModel describes an event sent by a simulation run, and how
+// the notification setting responded to it.
+type simulationEventModel struct {
+	ID           types.String `tfsdk:"id"`
+	EventType    types.String `tfsdk:"event_type"`
+	Status       types.String `tfsdk:"status"`
+	ResponseCode types.Int64  `tfsdk:"response_code"`
+	ResponseBody types.String `tfsdk:"response_body"`
+}
+
+var simulationEventAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"event_type":    types.StringType,
+	"status":        types.StringType,
+	"response_code": types.Int64Type,
+	"response_body": types.StringType,
+}
+
+var simulationRunAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"status":     types.StringType,
+	"created_at": types.StringType,
+	"events":     types.ListType{ElemType: types.ObjectType{AttrTypes: simulationEventAttrTypes}},
+}
+
+// Metadata returns the resource type name.
+func (r *SimulationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_simulation"
+}
+
+// Schema returns the resource schema definition.
+func (r *SimulationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Paddle simulation resource. Simulations send synthetic webhook events, either a single event or every event of a subscription scenario, to a notification setting. Set `run_trigger` to run the simulation.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle simulation ID (format: ntfsim_...)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_setting_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the notification setting the simulated events are sent to (format: ntfset_...).",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the simulation.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Event type to send, such as `subscription.created`, or scenario to play, one of: `subscription_creation`, `subscription_renewal`, `subscription_pause`, `subscription_resume`, `subscription_cancellation`. Changing between an event type and a scenario replaces the simulation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						simulationKindChanged,
+						"Changing between an event type and a scenario replaces the simulation.",
+						"Changing between an event type and a scenario replaces the simulation.",
+					),
+				},
+			},
+			"payload": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON object sent as the event data of a single event simulation, for example with `jsonencode()`. When not set, Paddle sends a demo payload. Only applies when `type` is an event type.",
+			},
+			"config": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON object with the `entities` and `options` of a scenario simulation, for example with `jsonencode()`. When not set, Paddle plays the default scenario with demo entities. Only applies when `type` is a scenario.",
+			},
+			"run_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value that runs the simulation when it is set, and again whenever it changes. The apply waits until Paddle has sent every event of the run.",
+			},
+			"require_delivery": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Fail the apply when an event of a run is not delivered successfully, instead of warning. Defaults to false.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the simulation. Either `active` or `archived`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_run_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the simulation was last run, from Terraform or elsewhere.",
+			},
+			"last_run": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last run started by `run_trigger`, with the delivery result of each event sent.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Paddle simulation run ID (format: ntfsimrun_...)",
+					},
+					"status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Status of the run. One of: `pending`, `completed`, `canceled`.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "RFC 3339 timestamp when the run was started.",
+					},
+					"events": schema.ListNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Events sent by the run.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Paddle simulation event ID (format: ntfsimevt_...)",
+								},
+								"event_type": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Type of the event sent.",
+								},
+								"status": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Delivery status of the event. One of: `pending`, `success`, `failed`, `aborted`.",
+								},
+								"response_code": schema.Int64Attribute{
+									Computed:            true,
+									MarkdownDescription: "HTTP status code the notification setting destination responded with. Null when it did not respond.",
+								},
+								"response_body": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Body the notification setting destination responded with.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema returns the resource identity schema definition.
+func (r *SimulationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle simulation ID (format: ntfsim_...)")
+}
+
+// Configure initializes the resource with the Paddle SDK client.
+func (r *SimulationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Paddle simulation, and runs it when run_trigger is set.
+func (r *SimulationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data simulationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "simulation", "create", createTimeout)
+	defer done()
+
+	// Build create request based on the kind of simulation
+	createReq, err := simulationCreateRequest(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating simulation", err.Error())
+		return
+	}
+
+	// Create simulation via Paddle API
+	simulation, err := r.client.CreateSimulation(ctx, createReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
+			"Error creating simulation",
+			"Could not create simulation",
+			err,
+		)
+		return
+	}
+	simulationToModel(simulation, &data)
+	data.LastRun = types.ObjectNull(simulationRunAttrTypes)
+
+	// Save the simulation before running it, so that it is tracked even if the run fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+	if resp.Diagnostics.HasError() || data.RunTrigger.IsNull() {
+		return
+	}
+
+	r.runSimulation(ctx, &data, req.Plan.Schema.Type(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read retrieves the current state of a Paddle simulation and of its last run.
+func (r *SimulationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data simulationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "simulation", "read", readTimeout)
+	defer done()
+
+	// Read simulation from Paddle API
+	simulation, err := r.client.GetSimulation(ctx, &paddle.GetSimulationRequest{
+		SimulationID: data.ID.ValueString(),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error reading simulation",
+			fmt.Sprintf("Could not read simulation ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Archived simulations are deleted as far as Terraform is concerned
+	if simulation.Status == paddle.StatusArchived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	simulationToModel(simulation, &data)
+
+	// Imported simulations have no run started by Terraform
+	if data.LastRun.IsNull() || data.LastRun.IsUnknown() {
+		data.LastRun = types.ObjectNull(simulationRunAttrTypes)
+	} else {
+		// Refresh the last run while Paddle is still sending its events
+		var lastRun simulationRunModel
+		resp.Diagnostics.Append(data.LastRun.As(ctx, &lastRun, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if lastRun.Status.ValueString() == string(paddle.SimulationRunStatusPending) {
+			run, err := r.client.GetSimulationRun(ctx, &paddle.GetSimulationRunRequest{
+				SimulationID:    data.ID.ValueString(),
+				SimulationRunID: lastRun.ID.ValueString(),
+				IncludeEvents:   true,
+			})
+			if err != nil {
+				helpers.AddAPIError(
+					&resp.Diagnostics,
+					req.State.Schema.Type(),
+					"Error reading simulation run",
+					fmt.Sprintf("Could not read run ID %s of simulation ID %s", lastRun.ID.ValueString(), data.ID.ValueString()),
+					err,
+				)
+				return
+			}
+			data.LastRun, diags = simulationRunToValue(ctx, run)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Update modifies an existing Paddle simulation, and runs it when run_trigger changed.
+func (r *SimulationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state simulationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "simulation", "update", updateTimeout)
+	defer done()
+
+	// Build update request based on the kind of simulation
+	updateReq, err := simulationUpdateRequest(state.ID.ValueString(), data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating simulation", err.Error())
+		return
+	}
+
+	// Update simulation via Paddle API
+	simulation, err := r.client.UpdateSimulation(ctx, updateReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
+			"Error updating simulation",
+			fmt.Sprintf("Could not update simulation ID %s", state.ID.ValueString()),
+			err,
+		)
+		return
+	}
+	simulationToModel(simulation, &data)
+	data.LastRun = state.LastRun
+
+	if !data.RunTrigger.IsNull() && !data.RunTrigger.Equal(state.RunTrigger) {
+		r.runSimulation(ctx, &data, req.Plan.Schema.Type(), &resp.Diagnostics)
+
+		// Keep the previous trigger when the run failed, so that the next apply runs it again
+		if resp.Diagnostics.HasError() {
+			data.RunTrigger = state.RunTrigger
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Delete archives a Paddle simulation by setting its status to archived.
+// Simulations cannot be hard-deleted in Paddle, only archived.
+func (r *SimulationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data simulationResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "simulation", "delete", deleteTimeout)
+	defer done()
+
+	// Archive the simulation by setting its status to "archived"
+	archived := paddle.NewPatchField(paddle.StatusArchived)
+	updateReq := paddle.NewUpdateSimulationRequestSimulationSingleEventUpdate(data.ID.ValueString(), &paddle.SimulationSingleEventUpdate{
+		Status: archived,
+	})
+	if isSimulationScenario(data.Type.ValueString()) {
+		updateReq = paddle.NewUpdateSimulationRequestSimulationScenarioUpdate(data.ID.ValueString(), &paddle.SimulationScenarioUpdate{
+			Status: archived,
+		})
+	}
+
+	_, err := r.client.UpdateSimulation(ctx, updateReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error archiving simulation",
+			fmt.Sprintf("Could not archive simulation ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ValidateConfig checks that type is an event type or a known scenario, and that
+// payload and config are JSON objects set for the matching kind of simulation.
+func (r *SimulationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data simulationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+	simulationType := data.Type.ValueString()
+	scenario := isSimulationScenario(simulationType)
+	if !scenario && !strings.Contains(simulationType, ".") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Simulation Type",
+			fmt.Sprintf("type must be an event type, such as subscription.created, or one of the scenarios: %s. Got: %s", joinScenarioTypes(), simulationType),
+		)
+		return
+	}
+
+	if !data.Payload.IsNull() {
+		if scenario {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("payload"),
+				"Attribute Not Supported",
+				"payload only applies to single event simulations. Use config to set the entities and options of a scenario.",
+			)
+		} else if !data.Payload.IsUnknown() {
+			var payload map[string]any
+			if err := json.Unmarshal([]byte(data.Payload.ValueString()), &payload); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("payload"),
+					"Invalid Payload",
+					fmt.Sprintf("payload must be a JSON object: %s", err),
+				)
+			}
+		}
+	}
+
+	if !data.Config.IsNull() {
+		if !scenario {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config"),
+				"Attribute Not Supported",
+				"config only applies to scenario simulations. Use payload to set the data of a single event.",
+			)
+		} else if !data.Config.IsUnknown() {
+			if _, err := simulationScenarioConfig(paddle.SimulationScenarioType(simulationType), data.Config.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config"),
+					"Invalid Scenario Config",
+					fmt.Sprintf("config must be a JSON object with the entities and options of the %s scenario: %s", simulationType, err),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan checks that single event simulations send an event type Paddle knows,
+// and plans a new last run when run_trigger changes.
+func (r *SimulationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan simulationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Type.IsNull() && !plan.Type.IsUnknown() && !isSimulationScenario(plan.Type.ValueString()) {
+		eventType := plan.Type.ValueString()
+		known := knownEventTypes(ctx, r.client)
+		if eventtypes.IsWildcard(eventType) || !slices.ContainsFunc(known, func(e *paddle.EventType) bool { return string(e.Name) == eventType }) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Unknown Event Type",
+				eventtypes.Hint(eventType, known),
+			)
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		// New simulations only have a run when run_trigger is set
+		if plan.RunTrigger.IsNull() {
+			plan.LastRun = types.ObjectNull(simulationRunAttrTypes)
+			plan.LastRunAt = types.StringNull()
+		}
+	} else {
+		var state simulationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Existing simulations only run again when run_trigger changes
+		if plan.RunTrigger.IsNull() || plan.RunTrigger.Equal(state.RunTrigger) {
+			plan.LastRun = state.LastRun
+			plan.LastRunAt = state.LastRunAt
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Runs the simulation and waits until Paddle has sent every event of the run,
+// then records the run in the model. Events that were not delivered are reported
+// as warnings, or as errors when require_delivery is set.
+func (r *SimulationResource) runSimulation(ctx context.Context, data *simulationResourceModel, schemaType attr.Type, diags *diag.Diagnostics) {
+	simulationID := data.ID.ValueString()
+
+	run, err := r.client.CreateSimulationRun(ctx, &paddle.CreateSimulationRunRequest{
+		SimulationID: simulationID,
+	})
+	if err != nil {
+		helpers.AddAPIError(diags, schemaType, "Error running simulation", fmt.Sprintf("Could not run simulation ID %s", simulationID), err)
+		return
+	}
+
+	// Read the run, with its events, until Paddle has sent all of them
+	for {
+		run, err = r.client.GetSimulationRun(ctx, &paddle.GetSimulationRunRequest{
+			SimulationID:    simulationID,
+			SimulationRunID: run.ID,
+			IncludeEvents:   true,
+		})
+		if err != nil {
+			helpers.AddAPIError(diags, schemaType, "Error reading simulation run", fmt.Sprintf("Could not read run of simulation ID %s", simulationID), err)
+			return
+		}
+		if run.Status != paddle.SimulationRunStatusPending {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(simulationRunPollInterval):
+		}
+	}
+
+	lastRun, d := simulationRunToValue(ctx, run)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	data.LastRun = lastRun
+
+	// Record when the simulation was last run, as Paddle sets it
+	simulation, err := r.client.GetSimulation(ctx, &paddle.GetSimulationRequest{SimulationID: simulationID})
+	if err != nil {
+		helpers.AddAPIError(diags, schemaType, "Error reading simulation", fmt.Sprintf("Could not read simulation ID %s", simulationID), err)
+		return
+	}
+	simulationToModel(simulation, data)
+
+	var undelivered []string
+	for _, event := range run.Events {
+		if event.Status == paddle.SimulationEventStatusSuccess {
+			continue
+		}
+		result := string(event.Status)
+		if event.Response != nil && event.Response.StatusCode != 0 {
+			result = fmt.Sprintf("%s, HTTP %d", event.Status, event.Response.StatusCode)
+		}
+		undelivered = append(undelivered, fmt.Sprintf("%s (%s)", event.EventType, result))
+	}
+	if len(undelivered) == 0 {
+		return
+	}
+
+	summary := "Simulated Events Not Delivered"
+	detail := fmt.Sprintf("Run %s of simulation %s did not deliver every event to notification setting %s: %s.", run.ID, simulationID, data.NotificationSettingID.ValueString(), strings.Join(undelivered, ", "))
+	if data.RequireDelivery.ValueBool() {
+		diags.AddError(summary, detail)
+	} else {
+		diags.AddWarning(summary, detail)
+	}
+}
+
+// Copies the attributes of a Paddle simulation into the resource model. payload
+// and config are kept as configured, as Paddle fills in demo data when they are
+// not set.
+func simulationToModel(simulation *paddle.Simulation, data *simulationResourceModel) {
+	data.ID = types.StringValue(simulation.ID)
+	data.NotificationSettingID = types.StringValue(simulation.NotificationSettingID)
+	data.Name = types.StringValue(simulation.Name)
+	data.Type = types.StringValue(string(simulation.Type))
+	data.Status = types.StringValue(string(simulation.Status))
+	data.LastRunAt = types.StringPointerValue(simulation.LastRunAt)
+}
+
+// Converts a simulation run into the last_run attribute value.
+func simulationRunToValue(ctx context.Context, run *paddle.SimulationRun) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	events := make([]simulationEventModel, len(run.Events))
+	for i, event := range run.Events {
+		events[i] = simulationEventModel{
+			ID:           types.StringValue(event.ID),
+			EventType:    types.StringValue(string(event.EventType)),
+			Status:       types.StringValue(string(event.Status)),
+			ResponseCode: types.Int64Null(),
+			ResponseBody: types.StringNull(),
+		}
+		if event.Response != nil {
+			if event.Response.StatusCode != 0 {
+				events[i].ResponseCode = types.Int64Value(int64(event.Response.StatusCode))
+			}
+			events[i].ResponseBody = types.StringValue(event.Response.Body)
+		}
+	}
+
+	eventsValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: simulationEventAttrTypes}, events)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(simulationRunAttrTypes), diags
+	}
+
+	value, d := types.ObjectValueFrom(ctx, simulationRunAttrTypes, simulationRunModel{
+		ID:        types.StringValue(run.ID),
+		Status:    types.StringValue(string(run.Status)),
+		CreatedAt: types.StringValue(run.CreatedAt),
+		Events:    eventsValue,
+	})
+	diags.Append(d...)
+	return value, diags
+}
+
+// Builds the request creating a single event or a scenario simulation.
+func simulationCreateRequest(data simulationResourceModel) (*paddle.CreateSimulationRequest, error) {
+	simulationType := data.Type.ValueString()
+
+	if isSimulationScenario(simulationType) {
+		scenario := &paddle.SimulationScenarioCreate{
+			NotificationSettingID: data.NotificationSettingID.ValueString(),
+			Name:                  data.Name.ValueString(),
+			Type:                  paddle.SimulationScenarioType(simulationType),
+		}
+		if !data.Config.IsNull() {
+			config, err := simulationScenarioConfig(scenario.Type, data.Config.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("Could not decode config: %w", err)
+			}
+			scenario.Config = config
+		}
+		return paddle.NewCreateSimulationRequestSimulationScenarioCreate(scenario), nil
+	}
+
+	singleEvent := &paddle.SimulationSingleEventCreate{
+		NotificationSettingID: data.NotificationSettingID.ValueString(),
+		Name:                  data.Name.ValueString(),
+		Type:                  paddle.EventTypeName(simulationType),
+	}
+	if !data.Payload.IsNull() {
+		singleEvent.Payload = json.RawMessage(data.Payload.ValueString())
+	}
+	return paddle.NewCreateSimulationRequestSimulationSingleEventCreate(singleEvent), nil
+}
+
+// Builds the request updating a single event or a scenario simulation. Unset
+// payload and config are cleared, so that Paddle sends demo data again.
+func simulationUpdateRequest(id string, data simulationResourceModel) (*paddle.UpdateSimulationRequest, error) {
+	simulationType := data.Type.ValueString()
+
+	if isSimulationScenario(simulationType) {
+		scenario := &paddle.SimulationScenarioUpdate{
+			NotificationSettingID: paddle.NewPatchField(data.NotificationSettingID.ValueString()),
+			Name:                  paddle.NewPatchField(data.Name.ValueString()),
+			Type:                  paddle.NewPatchField(paddle.SimulationScenarioType(simulationType)),
+			Config:                paddle.NewNullPatchField[*paddle.SimulationScenarioUpdateConfig](),
+		}
+		if !data.Config.IsNull() {
+			config, err := simulationScenarioConfig(paddle.SimulationScenarioType(simulationType), data.Config.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("Could not decode config: %w", err)
+			}
+			scenario.Config = paddle.NewPatchField((*paddle.SimulationScenarioUpdateConfig)(config))
+		}
+		return paddle.NewUpdateSimulationRequestSimulationScenarioUpdate(id, scenario), nil
+	}
+
+	singleEvent := &paddle.SimulationSingleEventUpdate{
+		NotificationSettingID: paddle.NewPatchField(data.NotificationSettingID.ValueString()),
+		Name:                  paddle.NewPatchField(data.Name.ValueString()),
+		Type:                  paddle.NewPatchField(paddle.EventTypeName(simulationType)),
+		Payload:               paddle.NewNullPatchField[*paddlenotification.NotificationPayload](),
+	}
+	if !data.Payload.IsNull() {
+		var payload paddlenotification.NotificationPayload = json.RawMessage(data.Payload.ValueString())
+		singleEvent.Payload = paddle.NewPatchField(&payload)
+	}
+	return paddle.NewUpdateSimulationRequestSimulationSingleEventUpdate(id, singleEvent), nil
+}
+
+// Decodes the config attribute of a scenario simulation, which holds the entities
+// and options of the scenario, into the configuration Paddle expects.
+func simulationScenarioConfig(scenario paddle.SimulationScenarioType, raw string) (*paddle.SimulationScenarioCreateConfig, error) {
+	config := &paddle.SimulationScenarioCreateConfig{}
+
+	var target any
+	switch scenario {
+	case paddle.SimulationScenarioTypeSubscriptionCreation:
+		config.SimulationSubscriptionCreation = &paddle.SimulationSubscriptionCreation{}
+		target = &config.SimulationSubscriptionCreation.SubscriptionCreation
+	case paddle.SimulationScenarioTypeSubscriptionRenewal:
+		config.SimulationSubscriptionRenewal = &paddle.SimulationSubscriptionRenewal{}
+		target = &config.SimulationSubscriptionRenewal.SubscriptionRenewal
+	case paddle.SimulationScenarioTypeSubscriptionPause:
+		config.SimulationSubscriptionPause = &paddle.SimulationSubscriptionPause{}
+		target = &config.SimulationSubscriptionPause.SubscriptionPause
+	case paddle.SimulationScenarioTypeSubscriptionResume:
+		config.SimulationSubscriptionResume = &paddle.SimulationSubscriptionResume{}
+		target = &config.SimulationSubscriptionResume.SubscriptionResume
+	case paddle.SimulationScenarioTypeSubscriptionCancellation:
+		config.SimulationSubscriptionCancellation = &paddle.SimulationSubscriptionCancellation{}
+		target = &config.SimulationSubscriptionCancellation.SubscriptionCancellation
+	default:
+		return nil, fmt.Errorf("unknown scenario %q", scenario)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Reports whether a simulation type is a scenario rather than a single event type.
+func isSimulationScenario(simulationType string) bool {
+	return slices.Contains(simulationScenarioTypes, paddle.SimulationScenarioType(simulationType))
+}
+
+// Lists the scenario types for error messages.
+func joinScenarioTypes() string {
+	names := make([]string, len(simulationScenarioTypes))
+	for i, scenario := range simulationScenarioTypes {
+		names[i] = string(scenario)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Requires replacing the simulation when type changes between an event type and
+// a scenario, as Paddle cannot update one kind of simulation into the other.
+func simulationKindChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	resp.RequiresReplace = isSimulationScenario(req.StateValue.ValueString()) != isSimulationScenario(req.PlanValue.ValueString())
+}
+
+// Imports an existing Paddle simulation by its ID.
+func (r *SimulationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,394 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSimulationResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create, run and Read testing
+			{
+				Config: testAccSimulationResourceConfig("Test simulation", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_simulation.test", "name", "Test simulation"),
+					resource.TestCheckResourceAttr("paddle_simulation.test", "type", "transaction.completed"),
+					resource.TestCheckResourceAttr("paddle_simulation.test", "status", "active"),
+					resource.TestCheckResourceAttrSet("paddle_simulation.test", "id"),
+					resource.TestCheckResourceAttrSet("paddle_simulation.test", "last_run.id"),
+					resource.TestCheckResourceAttr("paddle_simulation.test", "last_run.events.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "paddle_simulation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Runs started by Terraform are not recorded by Paddle on the simulation
+				ImportStateVerifyIgnore: []string{"run_trigger", "require_delivery", "last_run"},
+			},
+			// Update and run again
+			{
+				Config: testAccSimulationResourceConfig("Updated simulation", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_simulation.test", "name", "Updated simulation"),
+					resource.TestCheckResourceAttrSet("paddle_simulation.test", "last_run_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSimulationResource_scenario(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationResourceConfigScenario(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_simulation.test", "type", "subscription_creation"),
+					resource.TestCheckResourceAttrSet("paddle_simulation.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSimulationResourceConfig(name, runTrigger string) string {
+	return fmt.Sprintf(`
+resource "paddle_notification_setting" "test" {
+  description       = "Simulation webhook"
+  destination       = "https://example.com/webhook"
+  subscribed_events = ["transaction.completed"]
+}
+
+resource "paddle_simulation" "test" {
+  notification_setting_id = paddle_notification_setting.test.id
+  name                    = %q
+  type                    = "transaction.completed"
+  run_trigger             = %q
+}
+`, name, runTrigger)
+}
+
+func testAccSimulationResourceConfigScenario() string {
+	return `
+resource "paddle_notification_setting" "test" {
+  description       = "Simulation webhook"
+  destination       = "https://example.com/webhook"
+  subscribed_events = ["subscription.*"]
+}
+
+resource "paddle_simulation" "test" {
+  notification_setting_id = paddle_notification_setting.test.id
+  name                    = "New subscription"
+  type                    = "subscription_creation"
+  config = jsonencode({
+    options = {
+      customer_simulated_as = "new"
+    }
+  })
+}
+`
+}
+
+func TestSimulationResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]tftypes.Value
+		errorPaths []string
+	}{
+		{
+			name: "single event with payload",
+			attributes: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "transaction.completed"),
+				"payload": tftypes.NewValue(tftypes.String, `{"id":"txn_01"}`),
+			},
+		},
+		{
+			name: "single event with a payload that is not an object",
+			attributes: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "transaction.completed"),
+				"payload": tftypes.NewValue(tftypes.String, `["txn_01"]`),
+			},
+			errorPaths: []string{"payload"},
+		},
+		{
+			name: "single event with config",
+			attributes: map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "transaction.completed"),
+				"config": tftypes.NewValue(tftypes.String, `{}`),
+			},
+			errorPaths: []string{"config"},
+		},
+		{
+			name: "scenario with config",
+			attributes: map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "subscription_cancellation"),
+				"config": tftypes.NewValue(tftypes.String, `{"entities":{"subscription_id":"sub_01"},"options":{"effective_from":"next_billing_period"}}`),
+			},
+		},
+		{
+			name: "scenario with a misspelled option",
+			attributes: map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "subscription_cancellation"),
+				"config": tftypes.NewValue(tftypes.String, `{"options":{"efective_from":"immediately"}}`),
+			},
+			errorPaths: []string{"config"},
+		},
+		{
+			name: "scenario with payload",
+			attributes: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "subscription_renewal"),
+				"payload": tftypes.NewValue(tftypes.String, `{}`),
+			},
+			errorPaths: []string{"payload"},
+		},
+		{
+			name: "unknown scenario",
+			attributes: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "subscription_upgrade"),
+			},
+			errorPaths: []string{"type"},
+		},
+		{
+			name: "unknown payload",
+			attributes: map[string]tftypes.Value{
+				"type":    tftypes.NewValue(tftypes.String, "transaction.completed"),
+				"payload": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources.NewSimulationResource()
+			resourceSchema, raw := testResourceRaw(t, r, tt.attributes)
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: resourceSchema, Raw: raw},
+			}, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.errorPaths) {
+				t.Fatalf("expected errors on %v but got %v", tt.errorPaths, resp.Diagnostics)
+			}
+			for i, errorPath := range tt.errorPaths {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(path.Root(errorPath)) {
+					t.Errorf("expected an error on %s but got %v", errorPath, errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSimulationResource_ModifyPlan_eventType(t *testing.T) {
+	ctx := context.Background()
+	r := resources.NewSimulationResource()
+
+	for eventType, valid := range map[string]bool{
+		"transaction.completed": true,
+		"transaction.*":         false,
+		"transaction.finished":  false,
+		"subscription_renewal":  true,
+	} {
+		resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+			"type": tftypes.NewValue(tftypes.String, eventType),
+		})
+		resp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: raw.Copy()}}
+		r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: resourceSchema, Raw: raw},
+			State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(raw.Type(), nil)},
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%s: expected valid=%t but got %v", eventType, valid, resp.Diagnostics)
+		}
+	}
+}
+
+// Returns a simulation resource configured against a fake Paddle API whose
+// notification setting responds to the simulated event with responseCode, and
+// the requests it received.
+func testSimulationResource(t *testing.T, responseCode int) (fwresource.Resource, *[]string) {
+	t.Helper()
+
+	status := "success"
+	if responseCode >= 300 {
+		status = "failed"
+	}
+	simulation := `{"data":{"id":"ntfsim_01","status":"active","notification_setting_id":"ntfset_01","name":"Checkout","type":"transaction.completed","last_run_at":%s,"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"},"meta":{"request_id":"req_01"}}`
+
+	var requests []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body)))
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /simulations", "PATCH /simulations/ntfsim_01":
+			_, _ = fmt.Fprintf(w, simulation, "null")
+		case "GET /simulations/ntfsim_01":
+			_, _ = fmt.Fprintf(w, simulation, `"2024-01-01T00:01:00Z"`)
+		case "POST /simulations/ntfsim_01/runs":
+			_, _ = w.Write([]byte(`{"data":{"id":"ntfsimrun_01","status":"pending","type":"transaction.completed","created_at":"2024-01-01T00:01:00Z"},"meta":{"request_id":"req_01"}}`))
+		case "GET /simulations/ntfsim_01/runs/ntfsimrun_01":
+			_, _ = fmt.Fprintf(w, `{"data":{"id":"ntfsimrun_01","status":"completed","type":"transaction.completed","created_at":"2024-01-01T00:01:00Z","events":[{"id":"ntfsimevt_01","status":%q,"event_type":"transaction.completed","request":{"body":"{}"},"response":{"body":"ok","status_code":%d}}]},"meta":{"request_id":"req_01"}}`, status, responseCode)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return testConfigureResource(t, resources.NewSimulationResource(), "sandbox", mux), &requests
+}
+
+// Returns the attributes of a simulation of a completed transaction.
+func testSimulationAttributes(runTrigger string, requireDelivery bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, "ntfsim_01"),
+		"notification_setting_id": tftypes.NewValue(tftypes.String, "ntfset_01"),
+		"name":                    tftypes.NewValue(tftypes.String, "Checkout"),
+		"type":                    tftypes.NewValue(tftypes.String, "transaction.completed"),
+		"payload":                 tftypes.NewValue(tftypes.String, `{"id":"txn_01"}`),
+		"run_trigger":             tftypes.NewValue(tftypes.String, runTrigger),
+		"require_delivery":        tftypes.NewValue(tftypes.Bool, requireDelivery),
+		"status":                  tftypes.NewValue(tftypes.String, "active"),
+	}
+}
+
+// Creates a simulation with run_trigger set against a fake Paddle API whose
+// notification setting responds to the simulated event with responseCode.
+func createSimulation(t *testing.T, responseCode int, requireDelivery bool) (*fwresource.CreateResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+	r, requests := testSimulationResource(t, responseCode)
+
+	planAttributes := testSimulationAttributes("1", requireDelivery)
+	for _, name := range []string{"id", "status", "last_run_at"} {
+		planAttributes[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	resourceSchema, planRaw := testResourceRaw(t, r, planAttributes)
+	objectType := planRaw.Type().(tftypes.Object)
+	planValues := map[string]tftypes.Value{}
+	_ = planRaw.As(&planValues)
+	planValues["last_run"] = tftypes.NewValue(objectType.AttributeTypes["last_run"], tftypes.UnknownValue)
+	planRaw = tftypes.NewValue(objectType, planValues)
+
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+	identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+
+	resp := &fwresource.CreateResponse{
+		State:    tfsdk.State{Schema: resourceSchema, Raw: planRaw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: planRaw}}, resp)
+	return resp, *requests
+}
+
+func TestSimulationResource_Create_run(t *testing.T) {
+	ctx := context.Background()
+	resp, requests := createSimulation(t, http.StatusOK, false)
+
+	if resp.Diagnostics.HasError() || len(resp.Diagnostics.Warnings()) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(requests) != 4 || requests[0] != `POST /simulations {"notification_setting_id":"ntfset_01","name":"Checkout","type":"transaction.completed","payload":{"id":"txn_01"}}` || requests[1] != "POST /simulations/ntfsim_01/runs" {
+		t.Fatalf("expected the simulation to be created and run, got %v", requests)
+	}
+
+	var runID, eventStatus, lastRunAt types.String
+	var responseCode types.Int64
+	resp.State.GetAttribute(ctx, path.Root("last_run").AtName("id"), &runID)
+	resp.State.GetAttribute(ctx, path.Root("last_run").AtName("events").AtListIndex(0).AtName("status"), &eventStatus)
+	resp.State.GetAttribute(ctx, path.Root("last_run").AtName("events").AtListIndex(0).AtName("response_code"), &responseCode)
+	resp.State.GetAttribute(ctx, path.Root("last_run_at"), &lastRunAt)
+
+	if runID.ValueString() != "ntfsimrun_01" || eventStatus.ValueString() != "success" || responseCode.ValueInt64() != http.StatusOK {
+		t.Errorf("expected the completed run ntfsimrun_01 with a delivered event, got %s with %s (%s)", runID, eventStatus, responseCode)
+	}
+	if lastRunAt.ValueString() != "2024-01-01T00:01:00Z" {
+		t.Errorf("expected last_run_at to be read after the run, got %s", lastRunAt)
+	}
+}
+
+func TestSimulationResource_Create_undelivered(t *testing.T) {
+	resp, _ := createSimulation(t, http.StatusInternalServerError, false)
+	if resp.Diagnostics.HasError() || len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning for the undelivered event, got %v", resp.Diagnostics)
+	}
+
+	resp, _ = createSimulation(t, http.StatusInternalServerError, true)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error for the undelivered event when require_delivery is set, got %v", resp.Diagnostics)
+	}
+
+	var id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	if id.ValueString() != "ntfsim_01" {
+		t.Errorf("expected the simulation to stay in state, got %s", id)
+	}
+}
+
+func TestSimulationResource_Update_runFailed(t *testing.T) {
+	ctx := context.Background()
+	r, requests := testSimulationResource(t, http.StatusInternalServerError)
+
+	resourceSchema, stateRaw := testResourceRaw(t, r, testSimulationAttributes("1", true))
+	planAttributes := testSimulationAttributes("2", true)
+	planAttributes["last_run_at"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	_, planRaw := testResourceRaw(t, r, planAttributes)
+
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw: tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "ntfsim_01"),
+			"environment": tftypes.NewValue(tftypes.String, "sandbox"),
+		}),
+	}
+
+	resp := &fwresource.UpdateResponse{
+		State:    tfsdk.State{Schema: resourceSchema, Raw: planRaw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: identity.Schema, Raw: identity.Raw.Copy()},
+	}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:     tfsdk.Plan{Schema: resourceSchema, Raw: planRaw},
+		State:    tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+		Identity: identity,
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error for the undelivered event when require_delivery is set, got %v", resp.Diagnostics)
+	}
+	if len(*requests) < 2 || (*requests)[1] != "POST /simulations/ntfsim_01/runs" {
+		t.Fatalf("expected the simulation to be run, got %v", *requests)
+	}
+
+	// The previous trigger is kept, so that the next plan runs the simulation again
+	var runTrigger types.String
+	resp.State.GetAttribute(ctx, path.Root("run_trigger"), &runTrigger)
+	if runTrigger.ValueString() != "1" {
+		t.Errorf("expected run_trigger to stay 1 after the failed run, got %s", runTrigger)
+	}
+}
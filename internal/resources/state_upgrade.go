@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Transforms the raw JSON state of a resource from one schema version to the next.
+// Migrations only see attributes as stored by Terraform, so they can rename,
+// restructure or convert values without depending on the current model.
+type stateMigration func(ctx context.Context, state map[string]any) error
+
+// Migration for schema versions whose stored representation did not change,
+// such as when attributes only gained a custom type.
+func unchangedState(ctx context.Context, state map[string]any) error {
+	return nil
+}
+
+// Builds the state upgraders of a resource from its ordered list of migrations.
+// The migration at index N upgrades state from version N to version N+1, so the
+// current schema version must equal len(migrations). Terraform upgrades state in
+// a single step, so each prior version runs every remaining migration in order
+// before being decoded with the current schema.
+func stateUpgraders(ctx context.Context, r resource.Resource, migrations []stateMigration) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	currentSchema := schemaResp.Schema
+
+	upgraders := make(map[int64]resource.StateUpgrader, len(migrations))
+	for version := range migrations {
+		pending := migrations[version:]
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if currentSchema.Version != int64(len(migrations)) {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						fmt.Sprintf("Schema version %d does not match the %d registered state migrations. This is a bug in the provider.", currentSchema.Version, len(migrations)),
+					)
+					return
+				}
+
+				upgraded, err := migrateRawState(ctx, req.RawState, pending, currentSchema.Type().TerraformType(ctx))
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						fmt.Sprintf("Could not upgrade state from version %d to version %d: %s", version, currentSchema.Version, err.Error()),
+					)
+					return
+				}
+				resp.DynamicValue = upgraded
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// Applies migrations to a raw state and encodes the result for the given type.
+// Attributes that no longer exist in the current schema are dropped.
+func migrateRawState(ctx context.Context, raw *tfprotov6.RawState, migrations []stateMigration, typ tftypes.Type) (*tfprotov6.DynamicValue, error) {
+	if raw == nil || raw.JSON == nil {
+		return nil, fmt.Errorf("state is not stored as JSON")
+	}
+
+	state := map[string]any{}
+	if err := json.Unmarshal(raw.JSON, &state); err != nil {
+		return nil, fmt.Errorf("could not decode state: %w", err)
+	}
+
+	for _, migrate := range migrations {
+		if err := migrate(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+
+	migrated, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode state: %w", err)
+	}
+
+	value, err := (&tfprotov6.RawState{JSON: migrated}).UnmarshalWithOpts(typ, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("migrated state does not match the current schema: %w", err)
+	}
+
+	upgraded, err := tfprotov6.NewDynamicValue(typ, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &upgraded, nil
+}
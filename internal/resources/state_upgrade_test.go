@@ -0,0 +1,157 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Resources covered by the golden state tests, keyed by the golden file prefix.
+// Golden states live in testdata/state/<name>_v<version>.json, with one file per
+// schema version the resource has ever had.
+var goldenStateResources = map[string]func() fwresource.Resource{
+	"product":              resources.NewProductResource,
+	"price":                resources.NewPriceResource,
+	"discount":             resources.NewDiscountResource,
+	"customer":             resources.NewCustomerResource,
+	"notification_setting": resources.NewNotificationSettingResource,
+}
+
+func TestStateUpgraders_golden(t *testing.T) {
+	for name, newResource := range goldenStateResources {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newResource()
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			currentSchema := schemaResp.Schema
+			typ := currentSchema.Type().TerraformType(ctx)
+
+			// The current golden state must hold exactly the attributes of the current
+			// schema, so adding or removing an attribute requires updating it, and
+			// deciding whether the change needs a version bump and migration.
+			current := readGoldenState(t, name, currentSchema.Version)
+			checkGoldenStateAttributes(t, current, currentSchema)
+			expected, err := (&tfprotov6.RawState{JSON: current}).Unmarshal(typ)
+			if err != nil {
+				t.Fatalf("golden state for version %d does not match the current schema: %v", currentSchema.Version, err)
+			}
+
+			upgrader, ok := r.(fwresource.ResourceWithUpgradeState)
+			if !ok {
+				t.Fatal("resource does not implement ResourceWithUpgradeState")
+			}
+			upgraders := upgrader.UpgradeState(ctx)
+			if int64(len(upgraders)) != currentSchema.Version {
+				t.Fatalf("expected %d state upgraders for schema version %d, got %d", currentSchema.Version, currentSchema.Version, len(upgraders))
+			}
+
+			for version := int64(0); version < currentSchema.Version; version++ {
+				t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+					stateUpgrader, ok := upgraders[version]
+					if !ok {
+						t.Fatalf("missing state upgrader for version %d", version)
+					}
+
+					req := fwresource.UpgradeStateRequest{
+						RawState: &tfprotov6.RawState{JSON: readGoldenState(t, name, version)},
+					}
+					resp := fwresource.UpgradeStateResponse{
+						State: tfsdk.State{
+							Schema: currentSchema,
+							Raw:    tftypes.NewValue(typ, nil),
+						},
+					}
+					stateUpgrader.StateUpgrader(ctx, req, &resp)
+					if resp.Diagnostics.HasError() {
+						t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+					}
+					if resp.DynamicValue == nil {
+						t.Fatal("expected upgraded state")
+					}
+
+					upgraded, err := resp.DynamicValue.Unmarshal(typ)
+					if err != nil {
+						t.Fatalf("could not decode upgraded state: %v", err)
+					}
+					if !upgraded.Equal(expected) {
+						diffs, _ := upgraded.Diff(expected)
+						t.Fatalf("upgraded state does not match golden state for version %d: %v", currentSchema.Version, diffs)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestStateUpgraders_invalidState(t *testing.T) {
+	ctx := context.Background()
+	r := resources.NewCustomerResource().(fwresource.ResourceWithUpgradeState)
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	req := fwresource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id": "ctm_01h8441jn5pcwrfhwh78jqt8hk", "marketing_consent": "yes"}`)},
+	}
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for state that does not match the schema")
+	}
+}
+
+// Fails when the top-level attributes of a golden state differ from the
+// attributes and blocks of a schema.
+func checkGoldenStateAttributes(t *testing.T, data []byte, s schema.Schema) {
+	t.Helper()
+
+	var golden map[string]json.RawMessage
+	if err := json.Unmarshal(data, &golden); err != nil {
+		t.Fatalf("could not decode golden state: %v", err)
+	}
+
+	expected := make(map[string]bool, len(s.Attributes)+len(s.Blocks))
+	for name := range s.Attributes {
+		expected[name] = true
+	}
+	for name := range s.Blocks {
+		expected[name] = true
+	}
+
+	for name := range expected {
+		if _, ok := golden[name]; !ok {
+			t.Errorf("golden state is missing schema attribute %q", name)
+		}
+	}
+	for name := range golden {
+		if !expected[name] {
+			t.Errorf("golden state has attribute %q, which is not in the schema", name)
+		}
+	}
+}
+
+// Reads the golden state of a resource at the given schema version.
+func readGoldenState(t *testing.T, name string, version int64) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "state", fmt.Sprintf("%s_v%d.json", name, version)))
+	if err != nil {
+		t.Fatalf("could not read golden state: %v", err)
+	}
+	return data
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Default operation timeouts, used when the timeouts block does not set one.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// Bounds ctx by the timeout of a resource operation, so the deadline reaches every
+// SDK call made with the returned context. The returned function must be deferred:
+// it releases the context and, when the deadline was reached, adds an error naming
+// the operation that timed out.
+func withOperationTimeout(ctx context.Context, diags *diag.Diagnostics, resourceName, operation string, timeout time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, func() {
+		defer cancel()
+
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return
+		}
+		diags.AddError(
+			fmt.Sprintf("Timed out during %s %s", resourceName, operation),
+			fmt.Sprintf("The %s operation on the %s did not complete within %s. Increase the %q value of the timeouts block if Paddle is responding slowly.", operation, resourceName, timeout, operation),
+		)
+	}
+}
@@ -0,0 +1,878 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/customtypes"
+	"github.com/HQarroum/terraform-provider-paddle/internal/helpers"
+	"github.com/HQarroum/terraform-provider-paddle/internal/paddleclient"
+	"github.com/HQarroum/terraform-provider-paddle/internal/validators"
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &TransactionResource{}
+var _ resource.ResourceWithImportState = &TransactionResource{}
+var _ resource.ResourceWithIdentity = &TransactionResource{}
+var _ resource.ResourceWithModifyPlan = &TransactionResource{}
+
+// Creates a new Paddle transaction resource.
+func NewTransactionResource() resource.Resource {
+	return &TransactionResource{}
+}
+
+// A Paddle transaction, kept in the draft, ready or billed states. Meant for
+// sandbox fixtures such as invoices, rather than for billing customers.
+type TransactionResource struct {
+	client *paddleclient.Client
+}
+
+type transactionResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Status          types.String   `tfsdk:"status"`
+	Items           types.List     `tfsdk:"items"`
+	CustomerID      types.String   `tfsdk:"customer_id"`
+	AddressID       types.String   `tfsdk:"address_id"`
+	BusinessID      types.String   `tfsdk:"business_id"`
+	DiscountID      types.String   `tfsdk:"discount_id"`
+	CurrencyCode    types.String   `tfsdk:"currency_code"`
+	CollectionMode  types.String   `tfsdk:"collection_mode"`
+	BillingDetails  types.Object   `tfsdk:"billing_details"`
+	CustomData      types.Map      `tfsdk:"custom_data"`
+	AllowProduction types.Bool     `tfsdk:"allow_production"`
+	InvoiceNumber   types.String   `tfsdk:"invoice_number"`
+	Totals          types.Object   `tfsdk:"totals"`
+	BilledAt        types.String   `tfsdk:"billed_at"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type transactionItemModel struct {
+	PriceID  types.String `tfsdk:"price_id"`
+	Quantity types.Int64  `tfsdk:"quantity"`
+}
+
+type billingDetailsModel struct {
+	EnableCheckout        types.Bool   `tfsdk:"enable_checkout"`
+	PurchaseOrderNumber   types.String `tfsdk:"purchase_order_number"`
+	AdditionalInformation types.String `tfsdk:"additional_information"`
+	PaymentTerms          types.Object `tfsdk:"payment_terms"`
+}
+
+type paymentTermsModel struct {
+	Frequency types.Int64  `tfsdk:"frequency"`
+	Interval  types.String `tfsdk:"interval"`
+}
+
+var transactionItemAttrTypes = map[string]attr.Type{
+	"price_id": types.StringType,
+	"quantity": types.Int64Type,
+}
+
+var paymentTermsAttrTypes = map[string]attr.Type{
+	"frequency": types.Int64Type,
+	"interval":  types.StringType,
+}
+
+var billingDetailsAttrTypes = map[string]attr.Type{
+	"enable_checkout":        types.BoolType,
+	"purchase_order_number":  types.StringType,
+	"additional_information": types.StringType,
+	"payment_terms":          types.ObjectType{AttrTypes: paymentTermsAttrTypes},
+}
+
+var transactionTotalsAttrTypes = map[string]attr.Type{
+	"subtotal":    customtypes.MoneyAmountType{},
+	"discount":    customtypes.MoneyAmountType{},
+	"tax":         customtypes.MoneyAmountType{},
+	"total":       customtypes.MoneyAmountType{},
+	"grand_total": customtypes.MoneyAmountType{},
+}
+
+// Metadata returns the resource type name.
+func (r *TransactionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction"
+}
+
+// Schema defines the schema for the transaction resource.
+func (r *TransactionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	amount := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:            true,
+			CustomType:          customtypes.MoneyAmountType{},
+			MarkdownDescription: description + " In the lowest denomination of the currency, as a string.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Paddle transaction resource, for sandbox fixtures such as draft transactions and issued invoices. " +
+			"Transactions are kept in the `draft`, `ready` or `billed` states, and are canceled when the resource is destroyed. " +
+			"Paddle cannot change billed transactions, so changing one replaces it. " +
+			"The resource refuses to plan or apply against a `production` provider unless `allow_production` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Paddle transaction ID (format: txn_...).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Status of the transaction: `draft`, `ready` or `billed`. Paddle marks transactions `ready` when they have a customer and an address, and `draft` otherwise. " +
+					"Set to `billed` to issue a manually-collected transaction as an invoice. Billed transactions cannot be moved back, so changing from `billed` replaces the transaction.",
+				Validators: []validator.String{
+					validators.TransactionStatusValidator{},
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Prices the transaction bills for.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"price_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Paddle price ID (format: pri_...), for example from `paddle_price`.",
+						},
+						"quantity": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+							MarkdownDescription: "Quantity of the price billed. Defaults to 1.",
+						},
+					},
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Paddle ID of the customer this transaction is for (format: ctm_...), for example from `paddle_customer`.",
+			},
+			"address_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Paddle ID of the address of the customer this transaction is billed to (format: add_...). Requires `customer_id`.",
+			},
+			"business_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Paddle ID of the business of the customer this transaction is billed to (format: biz_...). Requires `customer_id`.",
+			},
+			"discount_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Paddle ID of the discount to apply (format: dsc_...), for example from `paddle_discount`.",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Three-letter ISO 4217 currency code of the transaction. Must be `USD`, `EUR` or `GBP` when `collection_mode` is `manual`. Defaults to the currency Paddle picks.",
+				Validators: []validator.String{
+					validators.CurrencyCodeValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How payment is collected: `automatic` for checkout, or `manual` for invoices. Defaults to `automatic`.",
+				Validators: []validator.String{
+					validators.CollectionModeValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_details": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Invoicing details. Required when `collection_mode` is `manual`.",
+				Attributes: map[string]schema.Attribute{
+					"enable_checkout": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Whether the invoice may be paid using Paddle Checkout. Defaults to false.",
+					},
+					"purchase_order_number": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Customer purchase order number, shown on the invoice.",
+					},
+					"additional_information": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Notes shown on the invoice.",
+					},
+					"payment_terms": schema.SingleNestedAttribute{
+						Required:            true,
+						MarkdownDescription: "How long the customer has to pay the invoice once issued.",
+						Attributes: map[string]schema.Attribute{
+							"frequency": schema.Int64Attribute{
+								Required:            true,
+								MarkdownDescription: "Number of intervals (e.g., 30 with `day` for net 30).",
+							},
+							"interval": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Interval unit: `day`, `week`, `month` or `year`.",
+								Validators: []validator.String{
+									validators.IntervalValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"custom_data": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Custom data for this transaction.",
+			},
+			"allow_production": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Allow planning this transaction with a `production` provider. Transactions are meant for sandbox fixtures, so this defaults to false.",
+			},
+			"invoice_number": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Invoice number of the transaction, once it is billed.",
+			},
+			"totals": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Totals of the transaction, calculated by Paddle.",
+				Attributes: map[string]schema.Attribute{
+					"subtotal":    amount("Total before discount and tax."),
+					"discount":    amount("Total discount."),
+					"tax":         amount("Total tax on the subtotal."),
+					"total":       amount("Total after discount and tax."),
+					"grand_total": amount("Total after credits, that the customer pays."),
+				},
+			},
+			"billed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the transaction was billed. Null when it is not billed yet.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the transaction was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp when the transaction was last updated.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of a transaction.
+func (r *TransactionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = paddleIdentitySchema("Paddle transaction ID (format: txn_...)")
+}
+
+// Configure initializes the resource with the Paddle SDK client.
+func (r *TransactionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*paddleclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *paddleclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Paddle transaction.
+func (r *TransactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data transactionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkTransactionEnvironment(r.client, data.AllowProduction, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "transaction", "create", createTimeout)
+	defer done()
+
+	items, diags := transactionItemsFromList(ctx, data.Items)
+	resp.Diagnostics.Append(diags...)
+	billingDetails, diags := billingDetailsFromObject(ctx, data.BillingDetails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &paddle.CreateTransactionRequest{
+		CustomerID:     data.CustomerID.ValueStringPointer(),
+		AddressID:      data.AddressID.ValueStringPointer(),
+		BusinessID:     data.BusinessID.ValueStringPointer(),
+		DiscountID:     data.DiscountID.ValueStringPointer(),
+		BillingDetails: billingDetails,
+	}
+	for _, item := range items {
+		createReq.Items = append(createReq.Items, *paddle.NewCreateTransactionItemsTransactionItemFromCatalog(item))
+	}
+	if data.Status.ValueString() == string(paddle.TransactionStatusBilled) {
+		status := paddle.TransactionStatusBilled
+		createReq.Status = &status
+	}
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		currencyCode := paddle.CurrencyCode(data.CurrencyCode.ValueString())
+		createReq.CurrencyCode = &currencyCode
+	}
+	if !data.CollectionMode.IsNull() && !data.CollectionMode.IsUnknown() {
+		collectionMode := paddle.CollectionMode(data.CollectionMode.ValueString())
+		createReq.CollectionMode = &collectionMode
+	}
+
+	// Parse custom_data if present
+	if !data.CustomData.IsNull() && !data.CustomData.IsUnknown() {
+		customDataStr := make(map[string]string)
+		resp.Diagnostics.Append(data.CustomData.ElementsAs(ctx, &customDataStr, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.CustomData = helpers.MapToCustomData(customDataStr)
+	}
+
+	// Create transaction via Paddle API
+	transaction, err := r.client.CreateTransaction(ctx, createReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
+			"Error creating transaction",
+			"Could not create transaction",
+			err,
+		)
+		return
+	}
+
+	plannedStatus := data.Status
+	resp.Diagnostics.Append(transactionToModel(ctx, transaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+
+	// The plan predicts the status Paddle picks, but Paddle has the last word.
+	// The transaction is saved anyway, so that it is canceled on replacement.
+	if !plannedStatus.IsUnknown() && !plannedStatus.Equal(data.Status) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Unexpected transaction status",
+			fmt.Sprintf("Transaction ID %s was created as %s instead of %s.", data.ID.ValueString(), data.Status.ValueString(), plannedStatus.ValueString()),
+		)
+	}
+}
+
+// Read retrieves the current state of a Paddle transaction.
+func (r *TransactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data transactionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "transaction", "read", readTimeout)
+	defer done()
+
+	// Read transaction from Paddle API
+	transaction, err := r.client.GetTransaction(ctx, &paddle.GetTransactionRequest{
+		TransactionID: data.ID.ValueString(),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error reading transaction",
+			fmt.Sprintf("Could not read transaction ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	// Canceled transactions cannot be reopened, so they are deleted as far as
+	// Terraform is concerned and a new transaction is created
+	if transaction.Status == paddle.TransactionStatusCanceled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(transactionToModel(ctx, transaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported transactions have no allow_production yet
+	if data.AllowProduction.IsNull() {
+		data.AllowProduction = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Update updates a draft or ready Paddle transaction, and bills it when its
+// status changes to billed. ModifyPlan replaces billed transactions instead.
+func (r *TransactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state transactionResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	checkTransactionEnvironment(r.client, data.AllowProduction, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "transaction", "update", updateTimeout)
+	defer done()
+
+	// Only timeouts and allow_production can change on a billed transaction
+	if !transactionIsOpen(state.Status.ValueString()) {
+		state.AllowProduction = data.AllowProduction
+		state.Timeouts = data.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, state.ID))...)
+		return
+	}
+
+	items, diags := transactionItemsFromList(ctx, data.Items)
+	resp.Diagnostics.Append(diags...)
+	billingDetails, diags := billingDetailsFromObject(ctx, data.BillingDetails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateItems := make([]paddle.UpdateTransactionItems, len(items))
+	for i, item := range items {
+		updateItems[i] = *paddle.NewUpdateTransactionItemsTransactionItemFromCatalog(item)
+	}
+	updateReq := &paddle.UpdateTransactionRequest{
+		TransactionID: data.ID.ValueString(),
+		Items:         paddle.NewPatchField(updateItems),
+		CustomerID:    paddle.NewPatchField(data.CustomerID.ValueStringPointer()),
+		AddressID:     paddle.NewPatchField(data.AddressID.ValueStringPointer()),
+		BusinessID:    paddle.NewPatchField(data.BusinessID.ValueStringPointer()),
+		DiscountID:    paddle.NewPatchField(data.DiscountID.ValueStringPointer()),
+	}
+	if billingDetails != nil {
+		updateReq.BillingDetails = paddle.NewPatchField(&paddle.BillingDetailsUpdate{
+			EnableCheckout:        billingDetails.EnableCheckout,
+			PurchaseOrderNumber:   billingDetails.PurchaseOrderNumber,
+			AdditionalInformation: billingDetails.AdditionalInformation,
+			PaymentTerms:          billingDetails.PaymentTerms,
+		})
+	} else {
+		updateReq.BillingDetails = paddle.NewPatchField[*paddle.BillingDetailsUpdate](nil)
+	}
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		currencyCode := paddle.CurrencyCode(data.CurrencyCode.ValueString())
+		updateReq.CurrencyCode = paddle.NewPatchField(&currencyCode)
+	}
+	if !data.CollectionMode.IsNull() && !data.CollectionMode.IsUnknown() {
+		updateReq.CollectionMode = paddle.NewPatchField(paddle.CollectionMode(data.CollectionMode.ValueString()))
+	}
+	if data.Status.ValueString() == string(paddle.TransactionStatusBilled) {
+		updateReq.Status = paddle.NewPatchField(paddle.TransactionStatusBilled)
+	}
+
+	// Update or clear custom_data
+	if !data.CustomData.IsNull() && !data.CustomData.IsUnknown() {
+		customDataStr := make(map[string]string)
+		resp.Diagnostics.Append(data.CustomData.ElementsAs(ctx, &customDataStr, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.CustomData = paddle.NewPatchField(helpers.MapToCustomData(customDataStr))
+	} else {
+		updateReq.CustomData = paddle.NewPatchField[paddle.CustomData](nil)
+	}
+
+	// Update transaction via Paddle API
+	transaction, err := r.client.UpdateTransaction(ctx, updateReq)
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.Plan.Schema.Type(),
+			"Error updating transaction",
+			fmt.Sprintf("Could not update transaction ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(transactionToModel(ctx, transaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newResourceIdentity(r.client, data.ID))...)
+}
+
+// Delete cancels a Paddle transaction. Transactions cannot be deleted in
+// Paddle, and only draft, ready and billed transactions can be canceled:
+// others are paid or past due, and are only removed from Terraform state.
+func (r *TransactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data transactionResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := data.Status.ValueString()
+	if !transactionIsOpen(status) && status != string(paddle.TransactionStatusBilled) {
+		resp.Diagnostics.AddWarning(
+			"Transaction not canceled",
+			fmt.Sprintf("Transaction ID %s is %s, and Paddle can no longer cancel it. It was removed from Terraform state only.", data.ID.ValueString(), status),
+		)
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, done := withOperationTimeout(ctx, &resp.Diagnostics, "transaction", "delete", deleteTimeout)
+	defer done()
+
+	// Cancel the transaction by setting its status to "canceled"
+	_, err := r.client.UpdateTransaction(ctx, &paddle.UpdateTransactionRequest{
+		TransactionID: data.ID.ValueString(),
+		Status:        paddle.NewPatchField(paddle.TransactionStatusCanceled),
+	})
+	if err != nil {
+		helpers.AddAPIError(
+			&resp.Diagnostics,
+			req.State.Schema.Type(),
+			"Error canceling transaction",
+			fmt.Sprintf("Could not cancel transaction ID %s", data.ID.ValueString()),
+			err,
+		)
+		return
+	}
+}
+
+// ModifyPlan refuses transactions with a production provider unless
+// allow_production is set, predicts the status Paddle gives draft and ready
+// transactions, and replaces billed transactions, which Paddle cannot change.
+func (r *TransactionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying cancels the transaction, which is allowed everywhere
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan transactionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client is not configured when the provider configuration is unknown,
+	// in which case Create and Update check the environment instead
+	if r.client != nil {
+		checkTransactionEnvironment(r.client, plan.AllowProduction, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var state *transactionResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &transactionResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Paddle cannot change billed transactions, only cancel them
+	if state != nil && !transactionIsOpen(state.Status.ValueString()) {
+		resp.RequiresReplace = transactionChangedAttributes(plan, *state)
+		return
+	}
+
+	// Paddle marks transactions ready once they have a customer and an address
+	if plan.CustomerID.IsUnknown() || plan.AddressID.IsUnknown() {
+		return
+	}
+	complete := !plan.CustomerID.IsNull() && !plan.AddressID.IsNull()
+	switch {
+	case plan.Status.IsUnknown():
+		status := paddle.TransactionStatusDraft
+		if complete {
+			status = paddle.TransactionStatusReady
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(string(status)))...)
+	case plan.Status.ValueString() == string(paddle.TransactionStatusDraft) && complete:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid transaction status",
+			"Paddle marks transactions with a customer_id and an address_id as ready. Remove address_id to keep the transaction draft.",
+		)
+	case plan.Status.ValueString() != string(paddle.TransactionStatusDraft) && !complete:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid transaction status",
+			fmt.Sprintf("Transactions need a customer_id and an address_id to be %s.", plan.Status.ValueString()),
+		)
+	}
+}
+
+// Adds an error when the client calls the production environment and
+// allow_production is not true. An unknown allow_production counts as false.
+func checkTransactionEnvironment(client *paddleclient.Client, allowProduction types.Bool, diags *diag.Diagnostics) {
+	if client.Environment != "production" || allowProduction.ValueBool() {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("allow_production"),
+		"Transaction in production",
+		"The provider is configured for the production environment, where transactions bill real customers. "+
+			"paddle_transaction is meant for sandbox fixtures: set allow_production to true to plan it anyway.",
+	)
+}
+
+// Imports an existing Paddle transaction by its ID.
+func (r *TransactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	checkIdentityEnvironment(ctx, req.Identity, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// Returns whether Paddle can still change a transaction in this status.
+func transactionIsOpen(status string) bool {
+	return status == string(paddle.TransactionStatusDraft) || status == string(paddle.TransactionStatusReady)
+}
+
+// Returns the paths of the configurable attributes that differ between the
+// plan and the state of a transaction.
+func transactionChangedAttributes(plan, state transactionResourceModel) []path.Path {
+	attributes := map[string][2]attr.Value{
+		"status":          {plan.Status, state.Status},
+		"items":           {plan.Items, state.Items},
+		"customer_id":     {plan.CustomerID, state.CustomerID},
+		"address_id":      {plan.AddressID, state.AddressID},
+		"business_id":     {plan.BusinessID, state.BusinessID},
+		"discount_id":     {plan.DiscountID, state.DiscountID},
+		"currency_code":   {plan.CurrencyCode, state.CurrencyCode},
+		"collection_mode": {plan.CollectionMode, state.CollectionMode},
+		"billing_details": {plan.BillingDetails, state.BillingDetails},
+		"custom_data":     {plan.CustomData, state.CustomData},
+	}
+
+	var changed []path.Path
+	for name, values := range attributes {
+		if !values[0].IsUnknown() && !values[0].Equal(values[1]) {
+			changed = append(changed, path.Root(name))
+		}
+	}
+	return changed
+}
+
+// Returns the catalog items of a transaction from its items attribute.
+func transactionItemsFromList(ctx context.Context, list types.List) ([]*paddle.TransactionItemFromCatalog, diag.Diagnostics) {
+	var models []transactionItemModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	items := make([]*paddle.TransactionItemFromCatalog, len(models))
+	for i, model := range models {
+		items[i] = &paddle.TransactionItemFromCatalog{
+			PriceID:  model.PriceID.ValueString(),
+			Quantity: int(model.Quantity.ValueInt64()),
+		}
+	}
+	return items, diags
+}
+
+// Returns the billing details of a transaction from its billing_details
+// attribute, nil when it is not set.
+func billingDetailsFromObject(ctx context.Context, obj types.Object) (*paddle.BillingDetails, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var model billingDetailsModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	var paymentTerms paymentTermsModel
+	diags.Append(model.PaymentTerms.As(ctx, &paymentTerms, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &paddle.BillingDetails{
+		EnableCheckout:        model.EnableCheckout.ValueBool(),
+		PurchaseOrderNumber:   model.PurchaseOrderNumber.ValueString(),
+		AdditionalInformation: model.AdditionalInformation.ValueStringPointer(),
+		PaymentTerms: paddle.Duration{
+			Frequency: int(paymentTerms.Frequency.ValueInt64()),
+			Interval:  paddle.Interval(paymentTerms.Interval.ValueString()),
+		},
+	}, diags
+}
+
+// Copies the attributes of a Paddle transaction into the resource model.
+func transactionToModel(ctx context.Context, transaction *paddle.Transaction, data *transactionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(transaction.ID)
+	data.Status = types.StringValue(string(transaction.Status))
+	data.CustomerID = types.StringPointerValue(transaction.CustomerID)
+	data.AddressID = types.StringPointerValue(transaction.AddressID)
+	data.BusinessID = types.StringPointerValue(transaction.BusinessID)
+	data.DiscountID = types.StringPointerValue(transaction.DiscountID)
+	data.CurrencyCode = types.StringValue(string(transaction.CurrencyCode))
+	data.CollectionMode = types.StringValue(string(transaction.CollectionMode))
+	data.InvoiceNumber = types.StringPointerValue(transaction.InvoiceNumber)
+	data.BilledAt = types.StringPointerValue(transaction.BilledAt)
+	data.CreatedAt = types.StringValue(transaction.CreatedAt)
+	data.UpdatedAt = types.StringValue(transaction.UpdatedAt)
+
+	// Map items
+	items := make([]transactionItemModel, len(transaction.Items))
+	for i, item := range transaction.Items {
+		items[i] = transactionItemModel{
+			PriceID:  types.StringValue(item.Price.ID),
+			Quantity: types.Int64Value(int64(item.Quantity)),
+		}
+	}
+	itemsValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: transactionItemAttrTypes}, items)
+	diags.Append(d...)
+	data.Items = itemsValue
+
+	// Map billing_details
+	data.BillingDetails = types.ObjectNull(billingDetailsAttrTypes)
+	if details := transaction.BillingDetails; details != nil {
+		paymentTerms, d := types.ObjectValue(paymentTermsAttrTypes, map[string]attr.Value{
+			"frequency": types.Int64Value(int64(details.PaymentTerms.Frequency)),
+			"interval":  types.StringValue(string(details.PaymentTerms.Interval)),
+		})
+		diags.Append(d...)
+		purchaseOrderNumber := types.StringNull()
+		if details.PurchaseOrderNumber != "" {
+			purchaseOrderNumber = types.StringValue(details.PurchaseOrderNumber)
+		}
+		data.BillingDetails, d = types.ObjectValue(billingDetailsAttrTypes, map[string]attr.Value{
+			"enable_checkout":        types.BoolValue(details.EnableCheckout),
+			"purchase_order_number":  purchaseOrderNumber,
+			"additional_information": types.StringPointerValue(details.AdditionalInformation),
+			"payment_terms":          paymentTerms,
+		})
+		diags.Append(d...)
+	}
+
+	// Map totals
+	totals := transaction.Details.Totals
+	data.Totals, d = types.ObjectValue(transactionTotalsAttrTypes, map[string]attr.Value{
+		"subtotal":    customtypes.NewMoneyAmountValue(totals.Subtotal),
+		"discount":    customtypes.NewMoneyAmountValue(totals.Discount),
+		"tax":         customtypes.NewMoneyAmountValue(totals.Tax),
+		"total":       customtypes.NewMoneyAmountValue(totals.Total),
+		"grand_total": customtypes.NewMoneyAmountValue(totals.GrandTotal),
+	})
+	diags.Append(d...)
+
+	// Map custom_data
+	data.CustomData = types.MapNull(types.StringType)
+	if transaction.CustomData != nil {
+		customDataMap, err := helpers.CustomDataToMap(transaction.CustomData)
+		if err != nil {
+			diags.AddError(
+				"Error processing custom data",
+				fmt.Sprintf("Could not convert custom_data for transaction %s: %s", transaction.ID, err.Error()),
+			)
+			return diags
+		}
+		data.CustomData, d = types.MapValueFrom(ctx, types.StringType, customDataMap)
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,341 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransactionResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTransactionResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_transaction.test", "status", "draft"),
+					resource.TestCheckResourceAttr("paddle_transaction.test", "collection_mode", "manual"),
+					resource.TestCheckResourceAttr("paddle_transaction.test", "currency_code", "USD"),
+					resource.TestCheckResourceAttr("paddle_transaction.test", "items.0.quantity", "1"),
+					resource.TestCheckResourceAttr("paddle_transaction.test", "totals.subtotal", "1900"),
+					resource.TestCheckResourceAttrPair("paddle_transaction.test", "customer_id", "paddle_customer.test", "id"),
+					resource.TestCheckResourceAttrSet("paddle_transaction.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "paddle_transaction.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_production"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccTransactionResourceConfig(3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("paddle_transaction.test", "items.0.quantity", "3"),
+					resource.TestCheckResourceAttr("paddle_transaction.test", "totals.subtotal", "5700"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransactionResourceConfig(quantity int) string {
+	return fmt.Sprintf(`
+resource "paddle_product" "test" {
+  name         = "Test Product for Transaction"
+  tax_category = "standard"
+}
+
+resource "paddle_price" "test" {
+  product_id  = paddle_product.test.id
+  description = "Test price for transaction"
+
+  unit_price = {
+    amount        = "1900"
+    currency_code = "USD"
+  }
+}
+
+resource "paddle_customer" "test" {
+  email = "transaction-test@example.com"
+}
+
+resource "paddle_transaction" "test" {
+  items = [{
+    price_id = paddle_price.test.id
+    quantity = %d
+  }]
+  customer_id     = paddle_customer.test.id
+  currency_code   = "USD"
+  collection_mode = "manual"
+
+  billing_details = {
+    purchase_order_number = "PO-123"
+    payment_terms = {
+      frequency = 30
+      interval  = "day"
+    }
+  }
+}
+`, quantity)
+}
+
+// Returns a transaction resource backed by a fake Paddle API that answers
+// with a transaction of the given status, and records the requests it receives.
+func testTransactionResource(t *testing.T, environment, status string, requests *[]string) fwresource.Resource {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"data":{"id":"txn_01","status":%q,"currency_code":"USD","collection_mode":"manual","items":[{"price":{"id":"pri_01"},"quantity":1}],"details":{"totals":{"subtotal":"1900","discount":"0","tax":"0","total":"1900","grand_total":"1900"}},"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"},"meta":{"request_id":"req_01"}}`, status)
+	return testConfigureResource(t, resources.NewTransactionResource(), environment, testRecordingHandler(requests, body))
+}
+
+func TestTransactionResource_ModifyPlan_production(t *testing.T) {
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		allowProduction tftypes.Value
+		expectError     bool
+	}{
+		"allow_production=false":   {allowProduction: tftypes.NewValue(tftypes.Bool, false), expectError: true},
+		"allow_production=true":    {allowProduction: tftypes.NewValue(tftypes.Bool, true)},
+		"allow_production unknown": {allowProduction: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), expectError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			r := testTransactionResource(t, "production", "draft", &requests)
+			resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+				"status":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"allow_production": tc.allowProduction,
+			})
+
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: raw}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(raw.Type(), nil)},
+				Plan:  plan,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTransactionResource_Create_production(t *testing.T) {
+	ctx := context.Background()
+
+	// The plan-time check is skipped when the provider configuration is unknown,
+	// so Create checks the environment again
+	var requests []string
+	r := testTransactionResource(t, "production", "draft", &requests)
+	resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+		"items": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"price_id": tftypes.String, "quantity": tftypes.Number}}}, []tftypes.Value{
+			tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"price_id": tftypes.String, "quantity": tftypes.Number}}, map[string]tftypes.Value{
+				"price_id": tftypes.NewValue(tftypes.String, "pri_01"),
+				"quantity": tftypes.NewValue(tftypes.Number, 1),
+			}),
+		}),
+		"allow_production": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: raw.Copy()}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: raw}}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error without allow_production")
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no request to Paddle, got %v", requests)
+	}
+}
+
+func TestTransactionResource_ModifyPlan_status(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       tftypes.Value
+		addressID    tftypes.Value
+		expectStatus string
+		expectError  bool
+	}{
+		{
+			name:         "draft without address",
+			status:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			addressID:    tftypes.NewValue(tftypes.String, nil),
+			expectStatus: "draft",
+		},
+		{
+			name:         "ready with address",
+			status:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			addressID:    tftypes.NewValue(tftypes.String, "add_01"),
+			expectStatus: "ready",
+		},
+		{
+			name:         "billed with address",
+			status:       tftypes.NewValue(tftypes.String, "billed"),
+			addressID:    tftypes.NewValue(tftypes.String, "add_01"),
+			expectStatus: "billed",
+		},
+		{
+			name:        "draft with address",
+			status:      tftypes.NewValue(tftypes.String, "draft"),
+			addressID:   tftypes.NewValue(tftypes.String, "add_01"),
+			expectError: true,
+		},
+		{
+			name:        "billed without address",
+			status:      tftypes.NewValue(tftypes.String, "billed"),
+			addressID:   tftypes.NewValue(tftypes.String, nil),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var requests []string
+			r := testTransactionResource(t, "sandbox", "draft", &requests)
+			resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+				"status":      tt.status,
+				"customer_id": tftypes.NewValue(tftypes.String, "ctm_01"),
+				"address_id":  tt.addressID,
+			})
+
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: raw}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(raw.Type(), nil)},
+				Plan:  plan,
+			}, resp)
+
+			if tt.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var status types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("status"), &status)...)
+			if status.ValueString() != tt.expectStatus {
+				t.Errorf("expected status %s but got %s", tt.expectStatus, status)
+			}
+		})
+	}
+}
+
+func TestTransactionResource_ModifyPlan_billed(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	r := testTransactionResource(t, "sandbox", "billed", &requests)
+	resourceSchema, stateRaw := testResourceRaw(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "txn_01"),
+		"status":      tftypes.NewValue(tftypes.String, "billed"),
+		"customer_id": tftypes.NewValue(tftypes.String, "ctm_01"),
+		"address_id":  tftypes.NewValue(tftypes.String, "add_01"),
+	})
+	_, planRaw := testResourceRaw(t, r, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "txn_01"),
+		"status":      tftypes.NewValue(tftypes.String, "billed"),
+		"customer_id": tftypes.NewValue(tftypes.String, "ctm_02"),
+		"address_id":  tftypes.NewValue(tftypes.String, "add_01"),
+	})
+
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: planRaw}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+		Plan:  plan,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("customer_id")) {
+		t.Errorf("expected customer_id to require replacement, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestTransactionResource_Read_canceled(t *testing.T) {
+	ctx := context.Background()
+
+	for status, removed := range map[string]bool{"ready": false, "paid": false, "canceled": true} {
+		t.Run(status, func(t *testing.T) {
+			var requests []string
+			r := testTransactionResource(t, "sandbox", status, &requests)
+			resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "txn_01"),
+			})
+
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+			identityType := identitySchemaResp.IdentitySchema.Type().TerraformType(ctx)
+
+			resp := fwresource.ReadResponse{
+				State:    tfsdk.State{Schema: resourceSchema, Raw: raw.Copy()},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+			}
+			r.Read(ctx, fwresource.ReadRequest{State: tfsdk.State{Schema: resourceSchema, Raw: raw}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != removed {
+				t.Errorf("expected removed=%t, got state %v", removed, resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestTransactionResource_Delete(t *testing.T) {
+	tests := []struct {
+		status        string
+		expectCancel  bool
+		expectWarning bool
+	}{
+		{status: "draft", expectCancel: true},
+		{status: "ready", expectCancel: true},
+		{status: "billed", expectCancel: true},
+		{status: "completed", expectWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			var requests []string
+			r := testTransactionResource(t, "sandbox", "canceled", &requests)
+			resourceSchema, raw := testResourceRaw(t, r, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "txn_01"),
+				"status": tftypes.NewValue(tftypes.String, tt.status),
+			})
+
+			var resp fwresource.DeleteResponse
+			r.Delete(context.Background(), fwresource.DeleteRequest{State: tfsdk.State{Schema: resourceSchema, Raw: raw}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			canceled := len(requests) == 1 && strings.HasPrefix(requests[0], "PATCH /transactions/txn_01 ") && strings.Contains(requests[0], `"status":"canceled"`)
+			if canceled != tt.expectCancel || (!tt.expectCancel && len(requests) != 0) {
+				t.Errorf("expected cancel=%t, got %v", tt.expectCancel, requests)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != tt.expectWarning {
+				t.Errorf("expected warning=%t, got %v", tt.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
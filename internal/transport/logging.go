@@ -0,0 +1,137 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Name of the tflog subsystem used for Paddle API calls. Its level can be set
+// separately with the TF_LOG_PROVIDER_PADDLE_HTTP environment variable.
+const httpSubsystem = "http"
+
+// Name of the OpenTelemetry tracer creating spans for Paddle API calls.
+const tracerName = "github.com/HQarroum/terraform-provider-paddle/internal/transport"
+
+// Logs every Paddle API call and records it as a span.
+type loggingTransport struct {
+	next   http.RoundTripper
+	tracer trace.Tracer
+}
+
+// Wraps next so that each call is logged through the `http` tflog subsystem and
+// traced through the global OpenTelemetry tracer provider. Request and response
+// bodies are always buffered, so the request ID can be read from responses.
+// They are only logged at TRACE level, after redacting secrets, emails and API
+// keys.
+func WithLogging(next http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{
+		next:   next,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PADDLE", httpSubsystem))
+
+	fields := map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+
+	ctx, span := t.tracer.Start(ctx, "paddle "+req.Method+" "+req.URL.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	// The SDK's GetBody shares its buffer with Body, so the body is read once
+	// and replayed on a copy of the request.
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		logBody(ctx, "Paddle API request body", body, fields)
+	}
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		tflog.SubsystemDebug(ctx, httpSubsystem, "Paddle API call failed", fields)
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		fields["error"] = err.Error()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		tflog.SubsystemDebug(ctx, httpSubsystem, "Paddle API call failed", fields)
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	fields["status"] = res.StatusCode
+	span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+	if requestID := responseRequestID(body); requestID != "" {
+		fields["request_id"] = requestID
+		span.SetAttributes(attribute.String("paddle.request_id", requestID))
+	}
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, res.Status)
+	}
+
+	tflog.SubsystemDebug(ctx, httpSubsystem, "Paddle API call", fields)
+	logBody(ctx, "Paddle API response body", body, fields)
+
+	return res, nil
+}
+
+// Logs a redacted body at TRACE level.
+func logBody(ctx context.Context, msg string, body []byte, fields map[string]any) {
+	if len(body) == 0 {
+		return
+	}
+
+	tflog.SubsystemTrace(ctx, httpSubsystem, msg, fields, map[string]any{
+		"body": redactBody(string(body)),
+	})
+}
+
+// Returns the request ID from the `meta` object of a Paddle response body.
+func responseRequestID(body []byte) string {
+	var envelope struct {
+		Meta struct {
+			RequestID string `json:"request_id"`
+		} `json:"meta"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return ""
+	}
+	return envelope.Meta.RequestID
+}
@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestWithLogging(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_PADDLE_HTTP", "TRACE")
+
+	responseBody := `{"data":{"id":"ctm_01","email":"sam@example.com"},"meta":{"request_id":"b15ec92e-8688-40d4-a04d-a4a1e6e5ea64"}}`
+	var receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	requestBody := `{"email":"sam@example.com"}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/customers", strings.NewReader(requestBody))
+	res, err := WithLogging(http.DefaultTransport).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer res.Body.Close()
+
+	// Bodies reach the server and the caller unchanged
+	if receivedBody != requestBody {
+		t.Fatalf("expected request body %s but got %s", requestBody, receivedBody)
+	}
+	body, _ := io.ReadAll(res.Body)
+	if string(body) != responseBody {
+		t.Fatalf("expected response body %s but got %s", responseBody, body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("could not decode logs: %v", err)
+	}
+
+	var call map[string]any
+	for _, entry := range entries {
+		if entry["@message"] == "Paddle API call" {
+			call = entry
+		}
+		if b, ok := entry["body"].(string); ok && strings.Contains(b, "sam@example.com") {
+			t.Fatalf("email was not redacted from logged body: %s", b)
+		}
+	}
+	if call == nil {
+		encoded, _ := json.Marshal(entries)
+		t.Fatalf("expected a log entry for the call, got %s", encoded)
+	}
+
+	expected := map[string]any{
+		"method":     http.MethodPost,
+		"path":       "/customers",
+		"status":     float64(http.StatusOK),
+		"request_id": "b15ec92e-8688-40d4-a04d-a4a1e6e5ea64",
+		"@module":    "provider.http",
+	}
+	for key, value := range expected {
+		if call[key] != value {
+			t.Fatalf("expected %s to be %v but got %v", key, value, call[key])
+		}
+	}
+	if _, ok := call["latency_ms"]; !ok {
+		t.Fatal("expected latency_ms in log entry")
+	}
+}
+
+func TestWithLogging_responseReadError(t *testing.T) {
+	readErr := errors.New("connection reset by peer")
+	next := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(io.MultiReader(strings.NewReader(`{"data":`), iotest.ErrReader(readErr))),
+		}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://sandbox-api.paddle.com/customers", nil)
+	res, err := WithLogging(next).RoundTrip(req)
+	if !errors.Is(err, readErr) {
+		t.Fatalf("expected error %v but got %v", readErr, err)
+	}
+	if res != nil {
+		t.Fatal("expected no response")
+	}
+}
+
+// Adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
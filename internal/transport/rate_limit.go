@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Default sustained rate of Paddle API calls.
+	DefaultRequestsPerSecond = 4.0
+
+	// Default number of calls that can be made at once before the rate applies.
+	DefaultBurst = 10
+
+	// Name of the tflog subsystem used for rate limit adjustments.
+	rateLimitSubsystem = "rate_limit"
+
+	// Lowest fraction of the configured rate the limiter slows down to.
+	minRateFraction = 1.0 / 16
+
+	// Fraction of the configured rate regained after each successful call.
+	recoveryRateFraction = 1.0 / 50
+)
+
+// Token-bucket limiter shared by every Paddle API call of a provider instance.
+// The rate is halved whenever Paddle answers with a rate limit response, and
+// recovers gradually towards the configured rate as calls succeed.
+type RateLimiter struct {
+	limiter *rate.Limiter
+	maxRate rate.Limit
+
+	mu sync.Mutex
+}
+
+// Creates a limiter allowing requestsPerSecond calls on average, with bursts of
+// up to burst calls.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst),
+		maxRate: rate.Limit(requestsPerSecond),
+	}
+}
+
+// Returns the current rate, in calls per second.
+func (l *RateLimiter) Rate() float64 {
+	return float64(l.limiter.Limit())
+}
+
+// Halves the current rate, down to a fraction of the configured one.
+func (l *RateLimiter) slowDown() rate.Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := max(l.limiter.Limit()/2, l.maxRate*minRateFraction)
+	l.limiter.SetLimit(limit)
+	return limit
+}
+
+// Raises the current rate by a step, up to the configured one.
+func (l *RateLimiter) recover() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit := l.limiter.Limit(); limit < l.maxRate {
+		l.limiter.SetLimit(min(limit+l.maxRate*recoveryRateFraction, l.maxRate))
+	}
+}
+
+// Waits for the shared limiter before each call.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+// Wraps next so that every call, including retries, waits for limiter.
+func WithRateLimit(next http.RoundTripper, limiter *RateLimiter) http.RoundTripper {
+	return &rateLimitTransport{next: next, limiter: limiter}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		limit := t.limiter.slowDown()
+		ctx := tflog.NewSubsystem(req.Context(), rateLimitSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PADDLE", rateLimitSubsystem))
+		tflog.SubsystemWarn(ctx, rateLimitSubsystem, "Paddle API rate limit reached, slowing down", map[string]any{
+			"requests_per_second": float64(limit),
+			"path":                req.URL.Path,
+		})
+	} else if res.StatusCode < 400 {
+		t.limiter.recover()
+	}
+
+	return res, nil
+}
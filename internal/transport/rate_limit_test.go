@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	status := http.StatusTooManyRequests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(1000, 10)
+	client := &http.Client{Transport: WithRateLimit(http.DefaultTransport, limiter)}
+
+	get := func() {
+		t.Helper()
+		res, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res.Body.Close()
+	}
+
+	// Rate limit responses halve the rate, down to the minimum
+	get()
+	if limiter.Rate() != 500 {
+		t.Fatalf("expected rate of 500 after a rate limit response but got %g", limiter.Rate())
+	}
+	for range 10 {
+		get()
+	}
+	if limiter.Rate() != 1000*minRateFraction {
+		t.Fatalf("expected minimum rate of %g but got %g", 1000*minRateFraction, limiter.Rate())
+	}
+
+	// Successful responses recover the configured rate
+	status = http.StatusOK
+	limiter.limiter.SetBurst(100)
+	for range 60 {
+		get()
+	}
+	if limiter.Rate() != 1000 {
+		t.Fatalf("expected rate to recover to 1000 but got %g", limiter.Rate())
+	}
+}
+
+func TestWithRateLimit_waits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: WithRateLimit(http.DefaultTransport, NewRateLimiter(20, 1))}
+
+	start := time.Now()
+	for range 5 {
+		res, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res.Body.Close()
+	}
+
+	// The first call uses the burst, the 4 others wait 50ms each
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected calls to be rate limited, took %s", elapsed)
+	}
+}
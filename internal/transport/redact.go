@@ -0,0 +1,26 @@
+package transport
+
+import (
+	"regexp"
+)
+
+// Replacement for redacted values in logged bodies.
+const redacted = "***"
+
+var (
+	// Matches JSON fields holding secrets, keeping the field name.
+	secretFieldRegex = regexp.MustCompile(`("(?:endpoint_secret_key|api_key|token|secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// Matches email addresses.
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	// Matches Paddle API keys and client-side tokens.
+	apiKeyRegex = regexp.MustCompile(`(?:pdl_(?:live|sdbx)_(?:apikey|ntfset)_[A-Za-z0-9_]+|(?:live|test)_[a-f0-9]{27})`)
+)
+
+// Redacts secrets, email addresses and API keys from a request or response body.
+func redactBody(body string) string {
+	body = secretFieldRegex.ReplaceAllString(body, `${1}"`+redacted+`"`)
+	body = emailRegex.ReplaceAllString(body, redacted)
+	return apiKeyRegex.ReplaceAllString(body, redacted)
+}
@@ -0,0 +1,47 @@
+package transport
+
+import (
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "endpoint secret key",
+			input:    `{"data":{"id":"ntfset_01","endpoint_secret_key":"pdl_ntfset_01gkpjp8bkm3tm53kdgkx6sms7_6h3qd3uFSi9YCD3OLYAShQI90XTI5vEI"}}`,
+			expected: `{"data":{"id":"ntfset_01","endpoint_secret_key":"***"}}`,
+		},
+		{
+			name:     "email",
+			input:    `{"data":{"name":"Sam Miller","email":"sam.miller@example.com"}}`,
+			expected: `{"data":{"name":"Sam Miller","email":"***"}}`,
+		},
+		{
+			name:     "api key",
+			input:    `invalid key pdl_sdbx_apikey_01jd9j2x8rhkm0p8xqcn1d7qqv_0nR9bZ5KpDR4QfT7N3mJcY_AXq`,
+			expected: `invalid key ***`,
+		},
+		{
+			name:     "client-side token",
+			input:    `{"data":{"token":"live_7d279f61a3499fed520f7cd8c08"}}`,
+			expected: `{"data":{"token":"***"}}`,
+		},
+		{
+			name:     "nothing to redact",
+			input:    `{"data":{"id":"pro_01","name":"Analytics Add-on"}}`,
+			expected: `{"data":{"id":"pro_01","name":"Analytics Add-on"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := redactBody(tt.input); result != tt.expected {
+				t.Fatalf("expected %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
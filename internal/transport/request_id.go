@@ -0,0 +1,91 @@
+// Package transport provides the HTTP round trippers wrapped around the Paddle SDK client.
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Key under which the request ID of a failed call is added to the `extra` object
+// of the Paddle error, where the SDK keeps it on paddleerr.Error.Extra.
+const ErrorRequestIDKey = "request_id"
+
+// Copies the request ID of failed Paddle API calls into the error object.
+// Paddle returns the request ID in the `meta` object of the response, which the
+// SDK discards when decoding errors, so it is moved into the error itself.
+type requestIDTransport struct {
+	next http.RoundTripper
+}
+
+// Wraps next so that Paddle errors carry the request ID of the failed call.
+func WithRequestID(next http.RoundTripper) http.RoundTripper {
+	return &requestIDTransport{next: next}
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode < 400 || !strings.Contains(res.Header.Get("Content-Type"), "application/json") {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	body = addErrorRequestID(body)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+	res.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return res, nil
+}
+
+// Returns the error envelope with meta.request_id copied into error.extra.
+// Bodies that are not a Paddle error envelope are returned unchanged.
+func addErrorRequestID(body []byte) []byte {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return body
+	}
+
+	var meta struct {
+		RequestID string `json:"request_id"`
+	}
+	var apiError map[string]json.RawMessage
+	if json.Unmarshal(envelope["meta"], &meta) != nil || meta.RequestID == "" ||
+		json.Unmarshal(envelope["error"], &apiError) != nil || apiError == nil {
+		return body
+	}
+
+	extra := map[string]any{}
+	if raw, ok := apiError["extra"]; ok && json.Unmarshal(raw, &extra) != nil {
+		return body
+	}
+	if extra == nil {
+		extra = map[string]any{}
+	}
+	if _, ok := extra[ErrorRequestIDKey]; ok {
+		return body
+	}
+	extra[ErrorRequestIDKey] = meta.RequestID
+
+	var err error
+	if apiError["extra"], err = json.Marshal(extra); err != nil {
+		return body
+	}
+	if envelope["error"], err = json.Marshal(apiError); err != nil {
+		return body
+	}
+
+	updated, err := json.Marshal(envelope)
+	if err != nil {
+		return body
+	}
+	return updated
+}
@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+	"github.com/PaddleHQ/paddle-go-sdk/v4/pkg/paddleerr"
+)
+
+func TestWithRequestID(t *testing.T) {
+	tests := []struct {
+		name              string
+		body              string
+		expectedRequestID any
+	}{
+		{
+			name:              "error with request ID",
+			body:              `{"error":{"type":"request_error","code":"not_found","detail":"Entity pro_123 not found"},"meta":{"request_id":"b15ec92e-8688-40d4-a04d-a4a1e6e5ea64"}}`,
+			expectedRequestID: "b15ec92e-8688-40d4-a04d-a4a1e6e5ea64",
+		},
+		{
+			name:              "error with existing extra",
+			body:              `{"error":{"type":"request_error","code":"not_found","detail":"Entity pro_123 not found","extra":{"entity":"product"}},"meta":{"request_id":"b15ec92e-8688-40d4-a04d-a4a1e6e5ea64"}}`,
+			expectedRequestID: "b15ec92e-8688-40d4-a04d-a4a1e6e5ea64",
+		},
+		{
+			name:              "error without meta",
+			body:              `{"error":{"type":"request_error","code":"not_found","detail":"Entity pro_123 not found"}}`,
+			expectedRequestID: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := paddle.New(
+				"test",
+				paddle.WithBaseURL(server.URL),
+				paddle.WithClient(&http.Client{Transport: WithRequestID(http.DefaultTransport)}),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err = client.GetProduct(context.Background(), &paddle.GetProductRequest{ProductID: "pro_123"})
+
+			var apiErr *paddleerr.Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected a Paddle error but got %v", err)
+			}
+			if apiErr.Code != "not_found" {
+				t.Fatalf("expected code not_found but got %s", apiErr.Code)
+			}
+			if apiErr.Extra[ErrorRequestIDKey] != tt.expectedRequestID {
+				t.Fatalf("expected request ID %v but got %v", tt.expectedRequestID, apiErr.Extra[ErrorRequestIDKey])
+			}
+		})
+	}
+}
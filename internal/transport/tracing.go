@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Service name reported on spans when OTEL_SERVICE_NAME is not set.
+const defaultServiceName = "terraform-provider-paddle"
+
+var configureTracingOnce sync.Once
+
+// Exports spans of Paddle API calls to the OTLP/HTTP endpoint set in
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT. The exporter
+// reads the other standard OTEL_EXPORTER_OTLP_* variables, such as headers.
+// Tracing stays disabled when neither endpoint is set. Spans are exported as
+// they end, since Terraform stops the provider without a chance to flush them.
+func ConfigureTracing(ctx context.Context) error {
+	var err error
+	configureTracingOnce.Do(func() {
+		if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+			return
+		}
+
+		exporter, exporterErr := otlptracehttp.New(ctx)
+		if exporterErr != nil {
+			err = exporterErr
+			return
+		}
+
+		serviceName := os.Getenv("OTEL_SERVICE_NAME")
+		if serviceName == "" {
+			serviceName = defaultServiceName
+		}
+
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(exporter),
+			sdktrace.WithResource(sdkresource.NewSchemaless(attribute.String("service.name", serviceName))),
+		))
+	})
+	return err
+}
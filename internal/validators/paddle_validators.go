@@ -236,3 +236,121 @@ func (v IntervalValidator) ValidateString(ctx context.Context, req validator.Str
 		)
 	}
 }
+
+// ProrationBillingModeValidator validates Paddle proration billing modes
+type ProrationBillingModeValidator struct{}
+
+func (v ProrationBillingModeValidator) Description(ctx context.Context) string {
+	return "must be one of the valid Paddle proration billing modes"
+}
+
+func (v ProrationBillingModeValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be one of: `prorated_immediately`, `prorated_next_billing_period`, `full_immediately`, `full_next_billing_period`, `do_not_bill`"
+}
+
+func (v ProrationBillingModeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	validModes := map[string]bool{
+		"prorated_immediately":         true,
+		"prorated_next_billing_period": true,
+		"full_immediately":             true,
+		"full_next_billing_period":     true,
+		"do_not_bill":                  true,
+	}
+
+	if !validModes[value] {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Proration Billing Mode",
+			fmt.Sprintf("Proration billing mode must be one of: prorated_immediately, prorated_next_billing_period, full_immediately, full_next_billing_period, do_not_bill. Got: %s", value),
+		)
+	}
+}
+
+// NotificationSettingTypeValidator validates Paddle notification setting types
+type NotificationSettingTypeValidator struct{}
+
+func (v NotificationSettingTypeValidator) Description(ctx context.Context) string {
+	return "must be one of the valid Paddle notification setting types"
+}
+
+func (v NotificationSettingTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be one of: `url`, `email`"
+}
+
+func (v NotificationSettingTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value != "url" && value != "email" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Notification Setting Type",
+			fmt.Sprintf("Notification setting type must be one of: url, email. Got: %s", value),
+		)
+	}
+}
+
+// TransactionStatusValidator validates the statuses a transaction resource can be in
+type TransactionStatusValidator struct{}
+
+func (v TransactionStatusValidator) Description(ctx context.Context) string {
+	return "must be one of the Paddle transaction statuses a transaction resource can be in"
+}
+
+func (v TransactionStatusValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be one of: `draft`, `ready`, `billed`"
+}
+
+func (v TransactionStatusValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	validStatuses := map[string]bool{
+		"draft":  true,
+		"ready":  true,
+		"billed": true,
+	}
+
+	if !validStatuses[value] {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Transaction Status",
+			fmt.Sprintf("Transaction status must be one of: draft, ready, billed. Got: %s", value),
+		)
+	}
+}
+
+// CollectionModeValidator validates Paddle collection modes
+type CollectionModeValidator struct{}
+
+func (v CollectionModeValidator) Description(ctx context.Context) string {
+	return "must be one of the valid Paddle collection modes"
+}
+
+func (v CollectionModeValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be one of: `automatic`, `manual`"
+}
+
+func (v CollectionModeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value != "automatic" && value != "manual" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Collection Mode",
+			fmt.Sprintf("Collection mode must be one of: automatic, manual. Got: %s", value),
+		)
+	}
+}
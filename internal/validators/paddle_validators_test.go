@@ -230,3 +230,144 @@ func TestCountryCodeValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestProrationBillingModeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"valid prorated_immediately", "prorated_immediately", false},
+		{"valid prorated_next_billing_period", "prorated_next_billing_period", false},
+		{"valid full_immediately", "full_immediately", false},
+		{"valid full_next_billing_period", "full_next_billing_period", false},
+		{"valid do_not_bill", "do_not_bill", false},
+		{"invalid mode", "prorated", true},
+		{"empty string", "", true},
+	}
+
+	v := ProrationBillingModeValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNotificationSettingTypeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"valid url", "url", false},
+		{"valid email", "email", false},
+		{"invalid type", "webhook", true},
+		{"empty string", "", true},
+	}
+
+	v := NotificationSettingTypeValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTransactionStatusValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"valid draft", "draft", false},
+		{"valid ready", "ready", false},
+		{"valid billed", "billed", false},
+		{"paid is not managed", "paid", true},
+		{"canceled is not managed", "canceled", true},
+		{"empty string", "", true},
+	}
+
+	v := TransactionStatusValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCollectionModeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"valid automatic", "automatic", false},
+		{"valid manual", "manual", false},
+		{"invalid mode", "invoice", true},
+		{"empty string", "", true},
+	}
+
+	v := CollectionModeValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			if tt.expectError && !resp.Diagnostics.HasError() {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectError && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+)
+
+// Name of the subcommand of the provider binary that simulates webhook deliveries.
+const SimulateCommand = "simulate-webhook"
+
+// Environment variable the endpoint secret key is read from when -secret is not set.
+const secretEnvVar = "PADDLE_WEBHOOK_SECRET"
+
+// Options of a simulated delivery.
+type simulateOptions struct {
+	secret       string
+	url          string
+	eventTypes   []string
+	badSignature bool
+	replayAge    time.Duration
+	dryRun       bool
+	timeout      time.Duration
+}
+
+// Runs the simulate-webhook subcommand with the arguments that follow it, and
+// returns the exit code of the process. It builds a webhook for each requested
+// event type from the embedded fixtures, signs it like Paddle does and POSTs it
+// to a local webhook handler.
+//
+// Deliveries are expected to be accepted with a 2xx response, unless they are
+// tampered with by -bad-signature or -replay-age, in which case they are expected
+// to be rejected with a 4xx response. The exit code is 1 when a response does not
+// match, so the command can be used in tests of webhook handlers.
+func RunSimulateCommand(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	opts, err := parseSimulateFlags(args, stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", SimulateCommand, err)
+		return 2
+	}
+
+	client := &http.Client{Timeout: opts.timeout}
+	failed := false
+	for _, eventType := range opts.eventTypes {
+		event, err := NewEvent(eventType, time.Now())
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", SimulateCommand, err)
+			return 1
+		}
+		body, err := json.Marshal(event)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: cannot encode %s: %s\n", SimulateCommand, eventType, err)
+			return 1
+		}
+		header := opts.signatureHeader(body)
+
+		if opts.dryRun {
+			fmt.Fprintf(stdout, "POST %s\n%s: %s\n\n%s\n\n", opts.url, SignatureHeader, header, body)
+			continue
+		}
+
+		status, err := deliver(ctx, client, opts.url, header, body)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: cannot deliver %s: %s\n", SimulateCommand, eventType, err)
+			return 1
+		}
+
+		result := "accepted"
+		if status < 200 || status >= 300 {
+			result = "rejected"
+		}
+		if expected := opts.expectedStatus(); status/100 != expected/100 {
+			failed = true
+			result += fmt.Sprintf(", expected %dxx", expected/100)
+		}
+		fmt.Fprintf(stdout, "%s %s: %d %s (%s)\n", eventType, event.EventID, status, http.StatusText(status), result)
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// Parses the flags of the simulate-webhook subcommand.
+func parseSimulateFlags(args []string, output io.Writer) (*simulateOptions, error) {
+	opts := &simulateOptions{}
+	var events string
+
+	flags := flag.NewFlagSet(SimulateCommand, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.StringVar(&opts.secret, "secret", os.Getenv(secretEnvVar), "endpoint_secret_key of the notification setting to sign webhooks with. Defaults to the "+secretEnvVar+" environment variable.")
+	flags.StringVar(&opts.url, "url", "http://localhost:8080/webhooks/paddle", "URL of the webhook handler to POST webhooks to.")
+	flags.StringVar(&events, "events", "transaction.completed", "Comma-separated event types to send. Wildcards such as subscription.* send every event type of an entity.")
+	flags.BoolVar(&opts.badSignature, "bad-signature", false, "Sign webhooks with a wrong secret, to check that the handler rejects them.")
+	flags.DurationVar(&opts.replayAge, "replay-age", 0, "Sign webhooks with a timestamp this far in the past, such as 10m, as a replayed webhook would be, to check that the handler rejects them.")
+	flags.BoolVar(&opts.dryRun, "dry-run", false, "Print the signed webhooks instead of sending them.")
+	flags.DurationVar(&opts.timeout, "timeout", 10*time.Second, "How long to wait for the handler to respond to each webhook.")
+	flags.Usage = func() {
+		fmt.Fprintf(output, "Usage: terraform-provider-paddle %s [flags]\n\nSends signed Paddle webhooks built from fixtures to a local webhook handler, without calling Paddle.\n\nFlags:\n", SimulateCommand)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	if opts.secret == "" {
+		return nil, fmt.Errorf("-secret or %s must be set", secretEnvVar)
+	}
+	if opts.replayAge < 0 {
+		return nil, fmt.Errorf("-replay-age must be positive, got %s", opts.replayAge)
+	}
+
+	var patterns []string
+	for _, pattern := range strings.Split(events, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	known := eventtypes.Embedded()
+	names, unknown := eventtypes.Expand(patterns, known)
+	if len(unknown) > 0 {
+		return nil, errors.New(eventtypes.Hint(unknown[0], known))
+	}
+	if len(names) == 0 {
+		return nil, errors.New("-events must name at least one event type")
+	}
+	opts.eventTypes = names
+
+	return opts, nil
+}
+
+// Returns the class of status codes the handler should respond with: 2xx, or 4xx
+// when the deliveries are tampered with.
+func (opts *simulateOptions) expectedStatus() int {
+	if opts.badSignature || opts.replayAge > 0 {
+		return http.StatusBadRequest
+	}
+	return http.StatusOK
+}
+
+// Returns the Paddle-Signature header of body, tampered with as requested.
+func (opts *simulateOptions) signatureHeader(body []byte) string {
+	secret := opts.secret
+	if opts.badSignature {
+		secret += "-bad"
+	}
+	return Sign(secret, time.Now().Add(-opts.replayAge), body)
+}
+
+// POSTs a signed webhook body to url, and returns the response status code.
+func deliver(ctx context.Context, client *http.Client, url, signatureHeader string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signatureHeader)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+// Starts a webhook handler that verifies signatures like the SDK middleware, and
+// records the event types it accepted.
+func testWebhookHandler(t *testing.T, secret string) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var accepted []string
+	verifier := paddle.NewWebhookVerifier(secret, paddle.VerifierWithTimestampTolerance(5*time.Minute))
+	server := httptest.NewServer(verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var event struct {
+			EventType string `json:"event_type"`
+		}
+		_ = json.Unmarshal(body, &event)
+		accepted = append(accepted, event.EventType)
+	})))
+	t.Cleanup(server.Close)
+	return server, &accepted
+}
+
+func TestRunSimulateCommand(t *testing.T) {
+	server, accepted := testWebhookHandler(t, "pdl_ntfset_secret")
+
+	var stdout, stderr bytes.Buffer
+	code := RunSimulateCommand(context.Background(), []string{"-secret", "pdl_ntfset_secret", "-url", server.URL, "-events", "transaction.completed,subscription.*"}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s%s", code, stdout.String(), stderr.String())
+	}
+	if len(*accepted) != 10 || (*accepted)[0] != "transaction.completed" || (*accepted)[1] != "subscription.activated" {
+		t.Errorf("expected transaction.completed and the 9 subscription events, got %v", *accepted)
+	}
+	if strings.Count(stdout.String(), "(accepted)") != 10 {
+		t.Errorf("expected every delivery to be reported as accepted, got %s", stdout.String())
+	}
+}
+
+func TestRunSimulateCommand_tampered(t *testing.T) {
+	server, accepted := testWebhookHandler(t, "pdl_ntfset_secret")
+
+	for _, args := range [][]string{{"-bad-signature"}, {"-replay-age", "10m"}} {
+		var stdout, stderr bytes.Buffer
+		args = append(args, "-secret", "pdl_ntfset_secret", "-url", server.URL)
+		if code := RunSimulateCommand(context.Background(), args, &stdout, &stderr); code != 0 {
+			t.Errorf("%v: expected the handler to reject the webhook, got exit code %d: %s%s", args, code, stdout.String(), stderr.String())
+		}
+	}
+	if len(*accepted) != 0 {
+		t.Errorf("expected no webhook to be accepted, got %v", *accepted)
+	}
+
+	// A handler that does not check signatures fails the command
+	lax := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer lax.Close()
+	var stdout, stderr bytes.Buffer
+	if code := RunSimulateCommand(context.Background(), []string{"-bad-signature", "-secret", "s", "-url", lax.URL}, &stdout, &stderr); code != 1 {
+		t.Errorf("expected exit code 1 when a bad signature is accepted, got %d", code)
+	}
+	if !strings.Contains(stdout.String(), "expected 4xx") {
+		t.Errorf("expected the unexpected acceptance to be reported, got %s", stdout.String())
+	}
+}
+
+func TestRunSimulateCommand_invalidFlags(t *testing.T) {
+	t.Setenv(secretEnvVar, "")
+
+	for _, args := range [][]string{
+		{},
+		{"-secret", "s", "-events", "transaction.complete"},
+		{"-secret", "s", "-replay-age", "-1m"},
+		{"-secret", "s", "extra"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := RunSimulateCommand(context.Background(), args, &stdout, &stderr); code != 2 {
+			t.Errorf("%v: expected exit code 2, got %d", args, code)
+		}
+	}
+}
+
+func TestRunSimulateCommand_dryRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := RunSimulateCommand(context.Background(), []string{"-secret", "s", "-dry-run", "-events", "customer.created"}, &stdout, &stderr)
+	if code != 0 || !strings.Contains(stdout.String(), "Paddle-Signature: ts=") || !strings.Contains(stdout.String(), `"event_type":"customer.created"`) {
+		t.Errorf("expected the signed webhook to be printed, got %d: %s%s", code, stdout.String(), stderr.String())
+	}
+}
@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Payloads of the entities webhooks are sent for, one file per entity. Each file
+// holds the entity as `data`, and the attributes that differ for some event types
+// as `overrides`, keyed by event type.
+//
+//go:embed fixtures/*.json
+var fixturesFS embed.FS
+
+type fixture struct {
+	Data      map[string]any            `json:"data"`
+	Overrides map[string]map[string]any `json:"overrides"`
+}
+
+var fixtures = sync.OnceValue(func() map[string]fixture {
+	entries, err := fixturesFS.ReadDir("fixtures")
+	if err != nil {
+		panic(fmt.Sprintf("webhooks: cannot read embedded fixtures: %s", err))
+	}
+
+	fixtures := make(map[string]fixture, len(entries))
+	for _, entry := range entries {
+		content, err := fixturesFS.ReadFile(path.Join("fixtures", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("webhooks: cannot read embedded fixture %s: %s", entry.Name(), err))
+		}
+		var f fixture
+		if err := json.Unmarshal(content, &f); err != nil {
+			panic(fmt.Sprintf("webhooks: invalid embedded fixture %s: %s", entry.Name(), err))
+		}
+		fixtures[strings.TrimSuffix(entry.Name(), ".json")] = f
+	}
+	return fixtures
+})
+
+// Event is a webhook body, as Paddle sends it to notification settings.
+type Event struct {
+	EventID        string         `json:"event_id"`
+	EventType      string         `json:"event_type"`
+	OccurredAt     string         `json:"occurred_at"`
+	NotificationID string         `json:"notification_id"`
+	Data           map[string]any `json:"data"`
+}
+
+// Builds the webhook body of an event type from the embedded fixtures, with new
+// event and notification IDs.
+func NewEvent(eventType string, occurredAt time.Time) (*Event, error) {
+	entity, _, _ := strings.Cut(eventType, ".")
+	f, ok := fixtures()[entity]
+	if !ok {
+		return nil, fmt.Errorf("no fixture for event type %q", eventType)
+	}
+
+	data := maps.Clone(f.Data)
+	maps.Copy(data, f.Overrides[eventType])
+
+	return &Event{
+		EventID:        newID("evt_"),
+		EventType:      eventType,
+		OccurredAt:     occurredAt.UTC().Format(time.RFC3339Nano),
+		NotificationID: newID("ntf_"),
+		Data:           data,
+	}, nil
+}
+
+// Characters of the random part of Paddle IDs.
+const idAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// Returns a random Paddle-style ID with the given prefix.
+func newID(prefix string) string {
+	random := make([]byte, 26)
+	_, _ = rand.Read(random)
+	for i, b := range random {
+		random[i] = idAlphabet[int(b)%len(idAlphabet)]
+	}
+	return prefix + string(random)
+}
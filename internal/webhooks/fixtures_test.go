@@ -0,0 +1,67 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HQarroum/terraform-provider-paddle/internal/eventtypes"
+)
+
+func TestNewEvent_everyEventType(t *testing.T) {
+	for _, eventType := range eventtypes.Embedded() {
+		name := string(eventType.Name)
+		event, err := NewEvent(name, time.Date(2024, 4, 12, 10, 18, 49, 0, time.UTC))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if event.EventType != name || event.OccurredAt != "2024-04-12T10:18:49Z" || event.Data["id"] == nil {
+			t.Errorf("%s: unexpected event %+v", name, event)
+		}
+		if !strings.HasPrefix(event.EventID, "evt_") || len(event.EventID) != 30 || !strings.HasPrefix(event.NotificationID, "ntf_") {
+			t.Errorf("%s: expected Paddle IDs, got %s and %s", name, event.EventID, event.NotificationID)
+		}
+	}
+}
+
+func TestNewEvent_overrides(t *testing.T) {
+	event, err := NewEvent("subscription.canceled", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Data["status"] != "canceled" || event.Data["canceled_at"] == nil {
+		t.Errorf("expected a canceled subscription, got %v", event.Data)
+	}
+
+	// Overrides do not leak into the fixture
+	event, _ = NewEvent("subscription.activated", time.Now())
+	if event.Data["status"] != "active" {
+		t.Errorf("expected an active subscription, got %v", event.Data["status"])
+	}
+}
+
+func TestFixtures_overridesKnownEventTypes(t *testing.T) {
+	known := map[string]bool{}
+	for _, eventType := range eventtypes.Embedded() {
+		known[string(eventType.Name)] = true
+	}
+
+	for entity, f := range fixtures() {
+		for eventType := range f.Overrides {
+			if !known[eventType] || !strings.HasPrefix(eventType, entity+".") {
+				t.Errorf("fixture %s overrides unknown event type %s", entity, eventType)
+			}
+		}
+		if _, err := json.Marshal(f.Data); err != nil {
+			t.Errorf("fixture %s: %v", entity, err)
+		}
+	}
+}
+
+func TestNewEvent_unknownEntity(t *testing.T) {
+	if _, err := NewEvent("invoice.created", time.Now()); err == nil {
+		t.Error("expected an error for an event type without fixture")
+	}
+}
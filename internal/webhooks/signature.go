@@ -0,0 +1,84 @@
+// Package webhooks builds, signs and verifies Paddle webhooks, and delivers
+// simulated ones so that webhook handlers can be tested without reaching Paddle.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Header Paddle signs webhooks in.
+const SignatureHeader = "Paddle-Signature"
+
+// Returns the Paddle-Signature header of a webhook body sent at timestamp, signed
+// with the endpoint secret key of a notification setting.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return "ts=" + ts + ";h1=" + signature(secret, ts, body)
+}
+
+// Returns the hex HMAC-SHA256 of `ts:body`, keyed with secret.
+func signature(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte(":"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Errors returned by Verify.
+var (
+	ErrMalformedHeader  = errors.New("malformed Paddle-Signature header, expected ts=<timestamp>;h1=<signature>")
+	ErrInvalidSignature = errors.New("signature does not match the body")
+	ErrExpiredTimestamp = errors.New("timestamp is outside the tolerance")
+)
+
+// Verifies the Paddle-Signature header of a webhook body against the endpoint
+// secret key of a notification setting. Paddle may send several h1 signatures
+// while a secret is rotated, and any of them may match.
+//
+// When tolerance is positive, the header timestamp must also be within tolerance
+// of now, so that replayed webhooks are rejected.
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var ts string
+	var signatures []string
+	for _, part := range strings.Split(header, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrMalformedHeader
+		}
+		switch key {
+		case "ts":
+			ts = value
+		case "h1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrMalformedHeader
+	}
+
+	expected := signature(secret, ts, body)
+	valid := false
+	for _, candidate := range signatures {
+		if hmac.Equal([]byte(candidate), []byte(expected)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if tolerance > 0 {
+		if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+			return ErrExpiredTimestamp
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	paddle "github.com/PaddleHQ/paddle-go-sdk/v4"
+)
+
+func TestSign(t *testing.T) {
+	// Example from https://developer.paddle.com/webhooks/signature-verification
+	got := Sign("pdl_ntfset_01gkpjp8bkm3tm53kdgkx6sms7_6h3qd3uFSi9YCD3OLYAShQI90XTI5vEI", time.Unix(1671552777, 0), []byte(`{"event_id":"evt_01gks14ge726w50ce2rdt1ft0a"}`))
+	if got[:len("ts=1671552777;h1=")] != "ts=1671552777;h1=" || len(got) != len("ts=1671552777;h1=")+64 {
+		t.Fatalf("expected a ts=...;h1=... header, got %s", got)
+	}
+
+	// The SDK verifies what Paddle would send
+	body := []byte(`{"event_id":"evt_01","event_type":"transaction.completed"}`)
+	verifier := paddle.NewWebhookVerifier("secret", paddle.VerifierWithTimestampTolerance(time.Minute))
+	for secret, valid := range map[string]bool{"secret": true, "other": false} {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, Sign(secret, time.Now(), body))
+		ok, err := verifier.Verify(req)
+		if err != nil || ok != valid {
+			t.Errorf("signed with %s: expected valid=%t, got %t (%v)", secret, valid, ok, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event_id":"evt_01","event_type":"transaction.completed"}`)
+	signedAt := time.Unix(1671552777, 0)
+	header := Sign("secret", signedAt, body)
+	h1 := header[len("ts=1671552777;"):]
+
+	for name, tc := range map[string]struct {
+		secret    string
+		header    string
+		body      string
+		tolerance time.Duration
+		now       time.Time
+		expected  error
+	}{
+		"valid":                 {secret: "secret", header: header, body: string(body), now: signedAt.Add(time.Hour)},
+		"within tolerance":      {secret: "secret", header: header, body: string(body), tolerance: 5 * time.Second, now: signedAt.Add(5 * time.Second)},
+		"rotated secret":        {secret: "secret", header: "ts=1671552777;h1=" + signature("old", "1671552777", body) + ";" + h1, body: string(body)},
+		"wrong secret":          {secret: "other", header: header, body: string(body), expected: ErrInvalidSignature},
+		"tampered body":         {secret: "secret", header: header, body: `{"event_id":"evt_02"}`, expected: ErrInvalidSignature},
+		"tampered timestamp":    {secret: "secret", header: "ts=1671552778;" + h1, body: string(body), expected: ErrInvalidSignature},
+		"replayed":              {secret: "secret", header: header, body: string(body), tolerance: 5 * time.Second, now: signedAt.Add(6 * time.Second), expected: ErrExpiredTimestamp},
+		"from the future":       {secret: "secret", header: header, body: string(body), tolerance: 5 * time.Second, now: signedAt.Add(-6 * time.Second), expected: ErrExpiredTimestamp},
+		"missing timestamp":     {secret: "secret", header: h1, body: string(body), expected: ErrMalformedHeader},
+		"missing signature":     {secret: "secret", header: "ts=1671552777", body: string(body), expected: ErrMalformedHeader},
+		"not a Paddle header":   {secret: "secret", header: "sha256=abc", body: string(body), expected: ErrMalformedHeader},
+		"non numeric timestamp": {secret: "secret", header: "ts=now;" + h1, body: string(body), expected: ErrMalformedHeader},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := Verify(tc.secret, tc.header, []byte(tc.body), tc.tolerance, tc.now)
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+}
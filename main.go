@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/HQarroum/terraform-provider-paddle/internal/provider"
+	"github.com/HQarroum/terraform-provider-paddle/internal/webhooks"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -13,6 +15,11 @@ import (
 var version string = "dev"
 
 func main() {
+	// Simulate webhook deliveries instead of serving the provider
+	if len(os.Args) > 1 && os.Args[1] == webhooks.SimulateCommand {
+		os.Exit(webhooks.RunSimulateCommand(context.Background(), os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")